@@ -0,0 +1,31 @@
+# Get all API keys
+data "pocketid_api_keys" "all" {}
+
+# API keys expiring within 14 days
+locals {
+  expiring_keys = [
+    for key in data.pocketid_api_keys.all.api_keys : key.name
+    if key.expires_in_days <= 14
+  ]
+
+  # API keys not used in the last 90 days (or never used)
+  stale_keys = [
+    for key in data.pocketid_api_keys.all.api_keys : key.name
+    if key.last_used_at == null ? true : timecmp(key.last_used_at, timeadd(plantimestamp(), "-2160h")) < 0
+  ]
+}
+
+# Fail the plan when a key is about to expire or has gone unused
+resource "terraform_data" "api_key_compliance" {
+  lifecycle {
+    precondition {
+      condition     = length(local.expiring_keys) == 0
+      error_message = "API keys expiring within 14 days: ${join(", ", local.expiring_keys)}"
+    }
+
+    precondition {
+      condition     = length(local.stale_keys) == 0
+      error_message = "API keys unused for 90 days: ${join(", ", local.stale_keys)}"
+    }
+  }
+}
@@ -0,0 +1,22 @@
+# Get the latest sign-ins of a user
+data "pocketid_audit_logs" "jane_sign_ins" {
+  user_id     = pocketid_user.jane.id
+  event       = "SIGN_IN"
+  max_results = 10
+}
+
+output "jane_sign_ins" {
+  value = [
+    for entry in data.pocketid_audit_logs.jane_sign_ins.audit_logs :
+    "${entry.created_at} from ${entry.ip_address} (${coalesce(entry.country, "unknown country")})"
+  ]
+}
+
+# Get the authorizations of a client during a time range
+data "pocketid_audit_logs" "wiki_authorizations" {
+  event       = "CLIENT_AUTHORIZATION"
+  client_name = pocketid_client.wiki.name
+  since       = "2026-01-01T00:00:00Z"
+  until       = "2026-02-01T00:00:00Z"
+  max_results = 500
+}
@@ -0,0 +1,10 @@
+# Get all signup tokens
+data "pocketid_signup_tokens" "all" {}
+
+# Tokens that still have uses left
+output "open_signup_tokens" {
+  value = [
+    for token in data.pocketid_signup_tokens.all.signup_tokens : token.id
+    if token.usage_count < token.usage_limit
+  ]
+}
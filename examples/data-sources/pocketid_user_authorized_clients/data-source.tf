@@ -0,0 +1,21 @@
+# Get the OIDC clients a user has authorized
+data "pocketid_user_authorized_clients" "jane" {
+  user_id = pocketid_user.jane.id
+}
+
+output "jane_authorized_clients" {
+  value = {
+    for authorized in data.pocketid_user_authorized_clients.jane.authorized_clients :
+    authorized.client_name => authorized.scopes
+  }
+}
+
+# Check whether the user has authorized a specific client
+data "pocketid_user_authorized_clients" "jane_wiki" {
+  user_id   = pocketid_user.jane.id
+  client_id = pocketid_client.wiki.id
+}
+
+output "jane_authorized_wiki" {
+  value = length(data.pocketid_user_authorized_clients.jane_wiki.authorized_clients) > 0
+}
@@ -0,0 +1,17 @@
+# Get the passkeys of a user
+data "pocketid_user_passkeys" "jane" {
+  user_id = pocketid_user.jane.id
+}
+
+# Whether the user has completed passkey registration
+output "jane_has_passkey" {
+  value = length(data.pocketid_user_passkeys.jane.passkeys) > 0
+}
+
+# Passkeys that are not synced to other devices
+output "jane_device_bound_passkeys" {
+  value = [
+    for passkey in data.pocketid_user_passkeys.jane.passkeys : passkey.name
+    if !passkey.backup_eligible
+  ]
+}
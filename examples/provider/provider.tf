@@ -19,8 +19,33 @@ provider "pocketid" {
   # Optional: Skip TLS certificate verification (only for development)
   # skip_tls_verify = true
 
+  # Optional: Trust an internal CA and present a client certificate (mTLS)
+  # ca_cert_file     = "/etc/ssl/internal-ca.pem"
+  # client_cert_file = "/etc/ssl/terraform.crt"
+  # client_key_file  = "/etc/ssl/terraform.key"
+
+  # Optional: Reach Pocket-ID through a proxy or an authenticating gateway
+  # proxy_url = "http://proxy.example.com:3128"
+  # headers = {
+  #   "CF-Access-Client-Id"     = var.cf_access_client_id
+  #   "CF-Access-Client-Secret" = var.cf_access_client_secret
+  # }
+
   # Optional: HTTP client timeout in seconds (default: 30)
   # timeout = 60
+
+  # Optional: Retry behaviour for failed requests
+  # max_retries    = 5
+  # retry_wait_min = 1
+  # retry_wait_max = 30
+  # retry_deadline = 120
+
+  # Optional: Smooth out requests on large configurations or high -parallelism
+  # max_requests_per_second = 10
+  # max_concurrent_requests = 4
+
+  # Optional: Cache reads so many data sources share a single list call
+  # request_cache = true
 }
 
 # Variable for API token to avoid hardcoding sensitive values
@@ -0,0 +1,24 @@
+# Issue an API key for a CI pipeline
+resource "pocketid_api_key" "ci" {
+  name        = "ci-pipeline"
+  description = "Used by the deployment pipeline"
+  expires_at  = "2030-01-01T00:00:00Z"
+}
+
+# The key is returned only once, on creation
+output "ci_api_key" {
+  description = "The API key for the CI pipeline"
+  value       = pocketid_api_key.ci.key
+  sensitive   = true
+}
+
+# Rotate a key every 90 days with the hashicorp/time provider.
+# API keys cannot be updated, so a new expires_at replaces the key.
+resource "time_rotating" "automation" {
+  rotation_days = 90
+}
+
+resource "pocketid_api_key" "automation" {
+  name       = "automation"
+  expires_at = timeadd(time_rotating.automation.rotation_rfc3339, "720h")
+}
@@ -0,0 +1,19 @@
+# Manage the branding of a Pocket-ID instance. This is a singleton resource:
+# only one should exist per instance. Only the images set here are managed;
+# the others keep their current value.
+variable "environment" {
+  description = "Environment whose branding is applied, e.g. production or staging"
+  type        = string
+  default     = "production"
+}
+
+resource "pocketid_application_images" "branding" {
+  logo_file       = "${path.module}/branding/logo.svg"
+  dark_logo_file  = "${path.module}/branding/logo-dark.svg"
+  favicon_file    = "${path.module}/branding/favicon.ico"
+  email_logo_file = "${path.module}/branding/email-logo.png"
+
+  # Images can also be passed as base64-encoded content; the image type is
+  # then detected from the content.
+  background_image_base64 = filebase64("${path.module}/branding/${var.environment}/background.jpg")
+}
@@ -0,0 +1,28 @@
+# A shared client, without the authoritative allowed_user_groups attribute
+resource "pocketid_client" "wiki" {
+  name = "Wiki"
+  callback_urls = [
+    "https://wiki.example.com/oauth/callback"
+  ]
+}
+
+# Each team grants its own group access to the client, without affecting the others
+resource "pocketid_group" "engineering" {
+  name          = "engineering"
+  friendly_name = "Engineering"
+}
+
+resource "pocketid_client_allowed_group" "wiki_engineering" {
+  client_id = pocketid_client.wiki.id
+  group_id  = pocketid_group.engineering.id
+}
+
+resource "pocketid_group" "support" {
+  name          = "support"
+  friendly_name = "Support"
+}
+
+resource "pocketid_client_allowed_group" "wiki_support" {
+  client_id = pocketid_client.wiki.id
+  group_id  = pocketid_group.support.id
+}
@@ -0,0 +1,10 @@
+# Incident response: make the user consent to the wiki again on their next
+# sign-in. Changing the incident ID revokes the authorization again.
+resource "pocketid_client_authorization_revocation" "jane_wiki" {
+  user_id   = pocketid_user.jane.id
+  client_id = pocketid_client.wiki.id
+
+  triggers = {
+    incident = "INC-1234"
+  }
+}
@@ -0,0 +1,24 @@
+resource "pocketid_client" "example" {
+  name          = "My Application"
+  callback_urls = ["https://app.example.com/callback"]
+}
+
+# Upload the logos shown on the login and consent pages.
+# The file extension tells Pocket-ID the image type.
+resource "pocketid_client_logo" "example" {
+  client_id      = pocketid_client.example.id
+  logo_file      = "${path.module}/logo.svg"
+  dark_logo_file = "${path.module}/logo-dark.svg"
+}
+
+# Images can also be passed as base64-encoded content. The image type is
+# detected from the content.
+resource "pocketid_client" "internal" {
+  name          = "Internal Tool"
+  callback_urls = ["https://tool.example.com/callback"]
+}
+
+resource "pocketid_client_logo" "internal" {
+  client_id   = pocketid_client.internal.id
+  logo_base64 = filebase64("${path.module}/tool.png")
+}
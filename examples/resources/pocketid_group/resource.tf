@@ -16,3 +16,18 @@ resource "pocketid_group" "with_claims" {
     environment = "production"
   }
 }
+
+# Manage the full member list from the group side.
+# Setting members replaces all members of the group, so do not also set
+# groups on the same users.
+resource "pocketid_user" "oncall" {
+  username = "jane.oncall"
+  email    = "jane.oncall@example.com"
+}
+
+resource "pocketid_group" "oncall" {
+  name          = "oncall"
+  friendly_name = "On-Call Rotation"
+
+  members = [pocketid_user.oncall.id]
+}
@@ -0,0 +1,12 @@
+resource "pocketid_group" "admins" {
+  name          = "admins"
+  friendly_name = "Administrators"
+}
+
+# Adds a claim to the tokens of every member of the group, keeping the
+# group's other claims
+resource "pocketid_group_custom_claim" "admins_role" {
+  group_id = pocketid_group.admins.id
+  key      = "role"
+  value    = "admin"
+}
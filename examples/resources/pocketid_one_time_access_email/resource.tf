@@ -0,0 +1,22 @@
+# Let admins send one-time access emails.
+resource "pocketid_application_config" "this" {
+  app_name                               = "My Pocket-ID"
+  smtp_host                              = "smtp.example.com"
+  smtp_port                              = "587"
+  smtp_from                              = "pocket-id@example.com"
+  email_one_time_access_as_admin_enabled = "true"
+  # ... other required configuration ...
+}
+
+# Email a login link to a user who lost their passkey. The token never
+# reaches the Terraform state. Changing `triggers` sends a new email.
+resource "pocketid_one_time_access_email" "jane" {
+  user_id = pocketid_user.jane.id
+  ttl     = "30m"
+
+  triggers = {
+    ticket = "HELP-1234"
+  }
+
+  depends_on = [pocketid_application_config.this]
+}
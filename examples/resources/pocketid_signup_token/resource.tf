@@ -0,0 +1,17 @@
+resource "pocketid_group" "contractors" {
+  name          = "contractors"
+  friendly_name = "Contractors"
+}
+
+# Invite up to five contractors for the next week
+resource "pocketid_signup_token" "contractors" {
+  ttl         = "168h"
+  usage_limit = 5
+  user_groups = [pocketid_group.contractors.id]
+}
+
+output "contractor_signup_url" {
+  description = "Share this link with the contractors"
+  value       = pocketid_signup_token.contractors.signup_url
+  sensitive   = true
+}
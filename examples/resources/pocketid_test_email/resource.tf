@@ -0,0 +1,22 @@
+# Configure SMTP via the application configuration.
+resource "pocketid_application_config" "this" {
+  app_name      = "My Pocket-ID"
+  smtp_host     = "smtp.example.com"
+  smtp_port     = "587"
+  smtp_from     = "pocket-id@example.com"
+  smtp_user     = "pocket-id"
+  smtp_password = var.smtp_password
+  # ... other required configuration ...
+}
+
+# Send a test email whenever the SMTP configuration changes, so a broken
+# configuration fails the apply instead of going unnoticed.
+# Changing any value in `triggers` sends a new test email.
+resource "pocketid_test_email" "smtp" {
+  triggers = {
+    smtp_host     = pocketid_application_config.this.smtp_host
+    smtp_port     = pocketid_application_config.this.smtp_port
+    smtp_user     = pocketid_application_config.this.smtp_user
+    smtp_password = sha256(var.smtp_password)
+  }
+}
@@ -0,0 +1,19 @@
+# Look up a user synced from LDAP, which is not managed by Terraform
+data "pocketid_users" "all" {}
+
+locals {
+  jane_id = one([for u in data.pocketid_users.all.users : u.id if u.username == "jane.doe"])
+}
+
+# Each claim is managed on its own and other claims of the user are kept
+resource "pocketid_user_custom_claim" "jane_department" {
+  user_id = local.jane_id
+  key     = "department"
+  value   = "engineering"
+}
+
+resource "pocketid_user_custom_claim" "jane_cost_center" {
+  user_id = local.jane_id
+  key     = "cost_center"
+  value   = "cc-1234"
+}
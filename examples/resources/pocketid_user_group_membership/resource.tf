@@ -0,0 +1,26 @@
+# A user managed by the identity team, without the authoritative groups attribute
+resource "pocketid_user" "jane" {
+  username = "jane.doe"
+  email    = "jane.doe@example.com"
+}
+
+# Each team adds the user to its own group, without affecting the others
+resource "pocketid_group" "platform" {
+  name          = "platform"
+  friendly_name = "Platform Team"
+}
+
+resource "pocketid_user_group_membership" "jane_platform" {
+  user_id  = pocketid_user.jane.id
+  group_id = pocketid_group.platform.id
+}
+
+resource "pocketid_group" "oncall" {
+  name          = "oncall"
+  friendly_name = "On-Call"
+}
+
+resource "pocketid_user_group_membership" "jane_oncall" {
+  user_id  = pocketid_user.jane.id
+  group_id = pocketid_group.oncall.id
+}
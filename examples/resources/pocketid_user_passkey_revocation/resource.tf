@@ -0,0 +1,17 @@
+# Offboarding: revoke all passkeys of a leaver. Changing the offboarding date
+# revokes passkeys registered since the last apply as well.
+resource "pocketid_user_passkey_revocation" "leaver" {
+  user_id = pocketid_user.jane.id
+
+  triggers = {
+    offboarded_on = "2026-10-16"
+  }
+}
+
+# Revoke a single lost passkey. Take the ID from the pocketid_user_passkeys
+# data source once and pin it here: the data source no longer lists the
+# passkey after it has been revoked.
+resource "pocketid_user_passkey_revocation" "lost_phone" {
+  user_id     = pocketid_user.john.id
+  passkey_ids = ["4f8a2c1e-93b7-4d2a-8e61-0c5b7d9f3a12"]
+}
@@ -0,0 +1,24 @@
+# Give a service account a recognizable avatar
+resource "pocketid_user" "deploy_bot" {
+  username   = "deploy-bot"
+  email      = "deploy-bot@example.com"
+  first_name = "Deploy"
+  last_name  = "Bot"
+}
+
+resource "pocketid_user_profile_picture" "deploy_bot" {
+  user_id              = pocketid_user.deploy_bot.id
+  profile_picture_file = "${path.module}/avatars/deploy-bot.png"
+}
+
+# Pictures can also be passed as base64-encoded content; the image type is
+# then detected from the content.
+resource "pocketid_user" "backup_bot" {
+  username = "backup-bot"
+  email    = "backup-bot@example.com"
+}
+
+resource "pocketid_user_profile_picture" "backup_bot" {
+  user_id                = pocketid_user.backup_bot.id
+  profile_picture_base64 = filebase64("${path.module}/avatars/backup-bot.jpg")
+}
@@ -0,0 +1,81 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestClient_CreateAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/api-keys", r.URL.Path)
+
+		var req client.APIKeyCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "ci", req.Name)
+		assert.Equal(t, "2030-01-01T00:00:00Z", req.ExpiresAt)
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprint(w, `{"apiKey": {"id": "key-123", "name": "ci"}, "token": "secret-token"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	result, err := c.CreateAPIKey(context.Background(), &client.APIKeyCreateRequest{Name: "ci", ExpiresAt: "2030-01-01T00:00:00Z"})
+	require.NoError(t, err)
+	assert.Equal(t, "key-123", result.APIKey.ID)
+	assert.Equal(t, "secret-token", result.Token)
+}
+
+func TestClient_GetAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/api-keys", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.PaginatedResponse[client.APIKey]{
+			Data:       []client.APIKey{{ID: "key-1", Name: "first"}, {ID: "key-2", Name: "second"}},
+			Pagination: client.PaginationInfo{TotalPages: 1, CurrentPage: 1},
+		}); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	apiKey, err := c.GetAPIKey(context.Background(), "key-2")
+	require.NoError(t, err)
+	assert.Equal(t, "second", apiKey.Name)
+
+	_, err = c.GetAPIKey(context.Background(), "key-3")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestClient_DeleteAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/api-keys/key-123", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.DeleteAPIKey(context.Background(), "key-123"))
+}
@@ -0,0 +1,104 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestClient_ListAuditLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/audit-logs/all", r.URL.Path)
+
+		query := r.URL.Query()
+		assert.Equal(t, "user-123", query.Get("filters[userId]"))
+		assert.Equal(t, "SIGN_IN", query.Get("filters[event]"))
+		assert.Equal(t, "Wiki", query.Get("filters[clientName]"))
+		assert.Equal(t, "createdAt", query.Get("sort[column]"))
+		assert.Equal(t, "desc", query.Get("sort[direction]"))
+		assert.Equal(t, "1", query.Get("pagination[page]"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "log-1",
+				"event": "SIGN_IN",
+				"ipAddress": "192.0.2.1",
+				"country": "Switzerland",
+				"city": "Bern",
+				"device": "Firefox on Linux",
+				"userID": "user-123",
+				"username": "alice",
+				"createdAt": "2026-03-04T05:06:07Z",
+				"data": {"clientName": "Wiki"}
+			}],
+			"pagination": {"totalPages": 1, "totalItems": 1, "currentPage": 1, "itemsPerPage": 100}
+		}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	resp, err := c.ListAuditLogs(context.Background(), client.AuditLogFilter{
+		UserID:     "user-123",
+		Event:      "SIGN_IN",
+		ClientName: "Wiki",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, client.AuditLog{
+		ID:        "log-1",
+		Event:     "SIGN_IN",
+		IPAddress: "192.0.2.1",
+		Country:   "Switzerland",
+		City:      "Bern",
+		Device:    "Firefox on Linux",
+		UserID:    "user-123",
+		Username:  "alice",
+		CreatedAt: "2026-03-04T05:06:07Z",
+		Data:      map[string]string{"clientName": "Wiki"},
+	}, resp.Data[0])
+}
+
+func TestClient_IterAuditLogs_KeepsFiltersAcrossPages(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "TOKEN_SIGN_IN", query.Get("filters[event]"))
+		assert.Empty(t, query.Get("filters[userId]"), "empty filters must not be sent")
+
+		page := query.Get("pagination[page]")
+		pages = append(pages, page)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{"id": "log-%[1]s", "event": "TOKEN_SIGN_IN", "createdAt": "2026-03-04T05:06:07Z"}],
+			"pagination": {"totalPages": 3, "totalItems": 3, "currentPage": %[1]s, "itemsPerPage": 1}
+		}`, page)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	var ids []string
+	for entry, err := range c.IterAuditLogs(context.Background(), client.AuditLogFilter{Event: "TOKEN_SIGN_IN"}) {
+		require.NoError(t, err)
+		ids = append(ids, entry.ID)
+		if len(ids) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"log-1", "log-2"}, ids)
+	assert.Equal(t, []string{"1", "2"}, pages, "breaking early must not fetch the remaining pages")
+}
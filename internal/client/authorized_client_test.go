@@ -0,0 +1,75 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestClient_ListUserAuthorizedClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/oidc/users/user-123/authorized-clients", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("pagination[page]"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"scope": "openid profile email",
+				"client": {"id": "client-123", "name": "Wiki"},
+				"lastUsedAt": "2026-03-04T05:06:07Z"
+			}],
+			"pagination": {"totalPages": 1, "totalItems": 1, "currentPage": 1, "itemsPerPage": 20}
+		}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	resp, err := c.ListUserAuthorizedClients(context.Background(), "user-123")
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "client-123", resp.Data[0].Client.ID)
+	assert.Equal(t, "Wiki", resp.Data[0].Client.Name)
+	assert.Equal(t, "openid profile email", resp.Data[0].Scope)
+	assert.Equal(t, "2026-03-04T05:06:07Z", resp.Data[0].LastUsedAt)
+}
+
+func TestClient_RevokeUserAuthorizedClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "revoked", status: http.StatusNoContent},
+		{name: "not authorized", status: http.StatusNotFound, wantErr: client.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/api/oidc/users/user-123/authorized-clients/client-123", r.URL.Path)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			err = c.RevokeUserAuthorizedClient(context.Background(), "user-123", "client-123")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
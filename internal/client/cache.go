@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// WithResponseCache enables an in-memory cache of GET responses for the
+// lifetime of the client. Identical concurrent GET requests are coalesced into
+// a single API call, and their response is reused by later GETs until any
+// write request (POST, PUT, PATCH or DELETE) is sent through the client.
+func WithResponseCache() Option {
+	return func(c *Client) {
+		c.cache = newResponseCache()
+	}
+}
+
+// responseCache stores GET response bodies by endpoint and coalesces
+// concurrent requests for the same endpoint.
+type responseCache struct {
+	mu       sync.Mutex
+	entries  map[string][]byte
+	inflight map[string]*cachedCall
+	// generation is incremented on every invalidation, so a response fetched
+	// while a write was in progress is not stored.
+	generation uint64
+}
+
+// cachedCall is a GET request in progress that other callers can wait on.
+// waiters counts the callers still waiting and is guarded by responseCache.mu.
+type cachedCall struct {
+	done    chan struct{}
+	body    []byte
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries:  map[string][]byte{},
+		inflight: map[string]*cachedCall{},
+	}
+}
+
+// get returns the cached response for endpoint, or calls fetch to retrieve it.
+// Callers arriving while fetch is running share its result. Errors are never
+// cached.
+//
+// fetch runs in the background on a context that keeps the values of ctx but
+// is not cancelled with it, so a caller that gives up, including the one that
+// started the fetch, only stops waiting and does not fail the other callers.
+// Once the last caller gives up, the fetch is cancelled and the next caller
+// starts a new one.
+func (rc *responseCache) get(ctx context.Context, endpoint string, fetch func(context.Context) ([]byte, error)) ([]byte, error) {
+	rc.mu.Lock()
+	if body, ok := rc.entries[endpoint]; ok {
+		rc.mu.Unlock()
+		return body, nil
+	}
+	call, ok := rc.inflight[endpoint]
+	if !ok {
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &cachedCall{done: make(chan struct{}), cancel: cancel}
+		rc.inflight[endpoint] = call
+		go rc.fetch(fetchCtx, endpoint, call, rc.generation, fetch)
+	}
+	call.waiters++
+	rc.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		rc.leave(endpoint, call)
+		return nil, ctx.Err()
+	}
+}
+
+// leave stops waiting on call and cancels it when no caller is left waiting.
+func (rc *responseCache) leave(endpoint string, call *cachedCall) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	call.waiters--
+	if call.waiters > 0 {
+		return
+	}
+	if rc.inflight[endpoint] == call {
+		delete(rc.inflight, endpoint)
+	}
+	call.cancel()
+}
+
+// fetch runs the shared request of call and stores its response, unless the
+// cache was invalidated since the request started.
+func (rc *responseCache) fetch(ctx context.Context, endpoint string, call *cachedCall, generation uint64, fetch func(context.Context) ([]byte, error)) {
+	defer call.cancel()
+	call.body, call.err = fetch(ctx)
+
+	rc.mu.Lock()
+	if rc.inflight[endpoint] == call {
+		delete(rc.inflight, endpoint)
+	}
+	if call.err == nil && generation == rc.generation {
+		rc.entries[endpoint] = call.body
+	}
+	rc.mu.Unlock()
+	close(call.done)
+}
+
+// invalidate drops every cached response.
+func (rc *responseCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries = map[string][]byte{}
+	rc.inflight = map[string]*cachedCall{}
+	rc.generation++
+}
+
+// doCachedRequest serves GET requests through the response cache and
+// invalidates it after any other request.
+func (c *Client) doCachedRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	if method != http.MethodGet {
+		defer c.cache.invalidate()
+		return c.doRequestWithRetry(ctx, method, endpoint, body)
+	}
+
+	return c.cache.get(ctx, endpoint, func(ctx context.Context) ([]byte, error) {
+		return c.doRequestWithRetry(ctx, method, endpoint, body)
+	})
+}
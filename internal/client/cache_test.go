@@ -0,0 +1,194 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// newCountingServer answers GET /api/users/{id} with a user and any other
+// request with an empty object, counting the requests per method.
+func newCountingServer(t *testing.T, delay time.Duration, gets, writes *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(gets, 1)
+		} else {
+			atomic.AddInt32(writes, 1)
+		}
+		time.Sleep(delay)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&client.User{ID: "test-id", Username: "test"}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_ResponseCache_ReusesGet(t *testing.T) {
+	var gets, writes int32
+	server := newCountingServer(t, 0, &gets, &writes)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	for range 3 {
+		user, err := c.GetUser(context.Background(), "test-id")
+		require.NoError(t, err)
+		assert.Equal(t, "test", user.Username)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets))
+
+	_, err = c.GetUser(context.Background(), "other-id")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets), "different endpoints are cached separately")
+}
+
+func TestClient_ResponseCache_CoalescesConcurrentGets(t *testing.T) {
+	var gets, writes int32
+	server := newCountingServer(t, 50*time.Millisecond, &gets, &writes)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetUser(context.Background(), "test-id")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets))
+}
+
+func TestClient_ResponseCache_LeaderCancellationDoesNotFailWaiters(t *testing.T) {
+	var gets, writes int32
+	server := newCountingServer(t, 100*time.Millisecond, &gets, &writes)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetUser(leaderCtx, "test-id")
+		leaderErr <- err
+	}()
+
+	// Join the request the leader started, then cancel the leader.
+	time.Sleep(20 * time.Millisecond)
+	time.AfterFunc(20*time.Millisecond, cancel)
+	user, err := c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "test", user.Username)
+
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets), "the waiter must share the leader's request")
+}
+
+func TestClient_ResponseCache_AllWaitersCancelledAbortsRequest(t *testing.T) {
+	var gets int32
+	started := make(chan struct{}, 1)
+	aborted := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gets, 1)
+		started <- struct{}{}
+		select {
+		case <-r.Context().Done():
+			close(aborted)
+		case <-time.After(5 * time.Second):
+			t.Error("request was not aborted")
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetUser(ctx, "test-id")
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+	}
+
+	<-started
+	// Give the other callers time to join the request before cancelling.
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	select {
+	case <-aborted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the shared request must be aborted once every caller gave up")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets), "the callers must share one request")
+}
+
+func TestClient_ResponseCache_InvalidatedOnWrite(t *testing.T) {
+	var gets, writes int32
+	server := newCountingServer(t, 0, &gets, &writes)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+
+	_, err = c.UpdateUser(context.Background(), "test-id", &client.UserCreateRequest{Username: "test"})
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&writes))
+}
+
+func TestClient_ResponseCache_ErrorsNotCached(t *testing.T) {
+	attempts := 0
+	server := newStatusServer(t, http.StatusNotFound, &attempts)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := c.GetUser(context.Background(), "test-id")
+		assert.ErrorIs(t, err, client.ErrNotFound)
+	}
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_ResponseCache_Disabled(t *testing.T) {
+	var gets, writes int32
+	server := newCountingServer(t, 0, &gets, &writes)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := c.GetUser(context.Background(), "test-id")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+}
@@ -5,10 +5,14 @@
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,27 +22,57 @@
 
 // Client represents a Pocket-ID API client
 type Client struct {
-	baseURL    string
-	apiToken   string
-	httpClient *http.Client
+	baseURL     string
+	apiToken    string
+	httpClient  *http.Client
+	pageSize    int
+	retryPolicy RetryPolicy
+	rateLimiter *tokenBucket
+	concurrency chan struct{}
+	cache       *responseCache
+	tls         tlsOptions
+	proxyURL    string
+	headers     map[string]string
+	locks       keyedMutex
+	memberships MembershipClaims
+
+	// serverVersion is set by DetectServerVersion.
+	serverVersion *Version
 }
 
-// RateLimitError represents a 429 rate limit error with optional Retry-After information
-type RateLimitError struct {
-	StatusCode int
-	Message    string
-	RetryAfter string // Can be seconds or HTTP-date
+// Option configures optional behaviour of a Client.
+type Option func(*Client)
+
+// WithPageSize sets the number of items requested per page by the list
+// methods. Values lower than 1 are ignored and DefaultPageSize is used.
+func WithPageSize(size int) Option {
+	return func(c *Client) {
+		if size > 0 {
+			c.pageSize = size
+		}
+	}
+}
+
+// WithProxyURL sends every request through the given HTTP or HTTPS proxy
+// instead of the proxy configured by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+// environment variables.
+func WithProxyURL(proxyURL string) Option {
+	return func(c *Client) {
+		c.proxyURL = proxyURL
+	}
 }
 
-func (e *RateLimitError) Error() string {
-	if e.RetryAfter != "" {
-		return fmt.Sprintf("HTTP %d: %s (Retry-After: %s)", e.StatusCode, e.Message, e.RetryAfter)
+// WithHeaders adds the given headers to every request, for example to
+// authenticate with a gateway in front of Pocket-ID. They cannot replace the
+// Content-Type, Accept and X-API-KEY headers set by the client.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = headers
 	}
-	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
 }
 
 // NewClient creates a new Pocket-ID API client
-func NewClient(baseURL, apiToken string, skipTLSVerify bool, timeout int64) (*Client, error) {
+func NewClient(baseURL, apiToken string, skipTLSVerify bool, timeout int64, opts ...Option) (*Client, error) {
 	if baseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
 	}
@@ -48,6 +82,7 @@ func NewClient(baseURL, apiToken string, skipTLSVerify bool, timeout int64) (*Cl
 
 	// Configure HTTP client with TLS settings
 	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
 		TLSClientConfig: &tls.Config{
 			// Allow users to skip TLS verification for development environments
 			// This is controlled by provider configuration and defaults to false
@@ -55,35 +90,65 @@ func NewClient(baseURL, apiToken string, skipTLSVerify bool, timeout int64) (*Cl
 		},
 	}
 
-	return &Client{
-		baseURL:  baseURL,
+	c := &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
 		apiToken: apiToken,
 		httpClient: &http.Client{
 			Timeout:   time.Duration(timeout) * time.Second,
 			Transport: transport,
 		},
-	}, nil
+		pageSize:    DefaultPageSize,
+		retryPolicy: DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if err := c.tls.apply(transport.TLSClientConfig); err != nil {
+		return nil, err
+	}
+
+	if c.proxyURL != "" {
+		proxyURL, err := url.Parse(c.proxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", c.proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return c, nil
 }
 
-// doRequest performs an HTTP request to the Pocket-ID API
-func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	return c.doRequestWithContext(context.Background(), method, endpoint, body)
+// doRequest performs an HTTP request to the Pocket-ID API, through the
+// response cache when it is enabled.
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	if c.cache != nil {
+		return c.doCachedRequest(ctx, method, endpoint, body)
+	}
+	return c.doRequestWithRetry(ctx, method, endpoint, body)
 }
 
-// doRequestWithContext performs an HTTP request to the Pocket-ID API with context support
-func (c *Client) doRequestWithContext(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
-	const maxRetries = 3
+// doRequestWithRetry performs an HTTP request to the Pocket-ID API. The
+// context bounds the whole call, including retries and their backoff. Failed
+// requests are retried according to the client's retry policy. Every attempt
+// is subject to the client's rate limit and concurrency limit.
+func (c *Client) doRequestWithRetry(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	policy := c.retryPolicy
+	var deadline time.Time
+	if policy.Deadline > 0 {
+		deadline = time.Now().Add(policy.Deadline)
+	}
 	var lastErr error
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
 		var backoff time.Duration
 
 		if attempt > 0 {
-			// Exponential backoff: 1s, 2s, 4s
-			backoff = time.Duration(1<<(attempt-1)) * time.Second
+			backoff = policy.backoff(attempt)
 
 			// Special handling for rate limit errors to respect Retry-After header
-			if rateLimitErr, ok := lastErr.(*RateLimitError); ok && rateLimitErr.RetryAfter != "" {
+			var rateLimitErr *RateLimitError
+			if errors.As(lastErr, &rateLimitErr) && rateLimitErr.RetryAfter != "" {
 				retryAfterSeconds := parseRetryAfter(rateLimitErr.RetryAfter)
 				if retryAfterSeconds > 0 {
 					backoff = time.Duration(retryAfterSeconds) * time.Second
@@ -94,6 +159,10 @@ func (c *Client) doRequestWithContext(ctx context.Context, method, endpoint stri
 				}
 			}
 
+			if !deadline.IsZero() && time.Now().Add(backoff).After(deadline) {
+				return nil, fmt.Errorf("retry deadline of %s exceeded after %d attempts: %w", policy.Deadline, attempt, lastErr)
+			}
+
 			tflog.Debug(ctx, "Retrying request after backoff", map[string]interface{}{
 				"attempt": attempt,
 				"backoff": backoff.String(),
@@ -107,70 +176,69 @@ func (c *Client) doRequestWithContext(ctx context.Context, method, endpoint stri
 			}
 		}
 
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
 		respBody, err := c.doSingleRequest(ctx, method, endpoint, body)
+		release()
 		if err == nil {
 			return respBody, nil
 		}
 
 		lastErr = err
 
-		// Determine if error is retryable
-		if !isRetryableError(err) {
+		// The caller gave up, so retrying would only fail again. Only the HTTP
+		// client's own timeout is retried.
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		// Determine if error is retryable for this method
+		if !shouldRetry(method, err) {
 			return nil, err
 		}
 
 		tflog.Warn(ctx, "Request failed with retryable error", map[string]interface{}{
 			"error":        err.Error(),
 			"attempt":      attempt + 1,
-			"max_attempts": maxRetries + 1,
+			"max_attempts": policy.MaxRetries + 1,
 		})
 	}
 
-	return nil, fmt.Errorf("request failed after %d attempts: %w", maxRetries+1, lastErr)
+	return nil, fmt.Errorf("request failed after %d attempts: %w", policy.MaxRetries+1, lastErr)
 }
 
-// isRetryableError determines if an error is retryable
-func isRetryableError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := err.Error()
-
-	// Network errors are retryable
-	if strings.Contains(errStr, "connection refused") ||
-		strings.Contains(errStr, "connection reset") ||
-		strings.Contains(errStr, "no such host") ||
-		strings.Contains(errStr, "timeout") {
-		return true
-	}
-
-	// 5xx errors are retryable
-	if strings.Contains(errStr, "HTTP 502") ||
-		strings.Contains(errStr, "HTTP 503") ||
-		strings.Contains(errStr, "HTTP 504") ||
-		strings.Contains(errStr, "HTTP 500") {
-		return true
-	}
-
-	// 429 Too Many Requests is retryable (rate limiting)
-	if strings.Contains(errStr, "HTTP 429") {
-		return true
-	}
+// requestURL joins the base URL, which may include a sub-path, and an API
+// endpoint such as "/api/users?pagination[page]=1".
+func (c *Client) requestURL(endpoint string) string {
+	return c.baseURL + "/" + strings.TrimLeft(endpoint, "/")
+}
 
-	// Check if it's a RateLimitError
-	if _, ok := err.(*RateLimitError); ok {
-		return true
+// headerNames returns the sorted names of the custom headers, for logging
+// without exposing their values.
+func (c *Client) headerNames() []string {
+	names := make([]string, 0, len(c.headers))
+	for name := range c.headers {
+		names = append(names, name)
 	}
-
-	return false
+	slices.Sort(names)
+	return names
 }
 
 // doSingleRequest performs a single HTTP request without retries
 func (c *Client) doSingleRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
 	var reqBody io.Reader
 	var reqBodyLog []byte
-	if body != nil {
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+	case *rawBody:
+		// Uploads are not logged, only their size.
+		reqBody = bytes.NewReader(b.content)
+		contentType = b.contentType
+		reqBodyLog = []byte(fmt.Sprintf("[%d bytes]", len(b.content)))
+	default:
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling request body: %w", err)
@@ -179,14 +247,18 @@ func (c *Client) doSingleRequest(ctx context.Context, method, endpoint string, b
 		reqBodyLog = jsonBody
 	}
 
-	url := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	url := c.requestURL(endpoint)
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
+	// Set headers. Custom headers are set first so they cannot replace the
+	// headers the API relies on.
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("X-API-KEY", c.apiToken) // Note: Using X-API-KEY header, not Authorization Bearer
 
@@ -200,6 +272,7 @@ func (c *Client) doSingleRequest(ctx context.Context, method, endpoint string, b
 			"Accept":       req.Header.Get("Accept"),
 			"X-API-KEY":    "[REDACTED]",
 		},
+		"custom_headers": c.headerNames(),
 	})
 
 	if reqBodyLog != nil {
@@ -242,37 +315,40 @@ func (c *Client) doSingleRequest(ctx context.Context, method, endpoint string, b
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     method,
+			Endpoint:   endpoint,
+			RequestID:  resp.Header.Get("X-Request-Id"),
+		}
+
 		var errResp ErrorResponse
 		if err := json.Unmarshal(respBody, &errResp); err != nil {
 			tflog.Error(ctx, "API Error Response", map[string]interface{}{
 				"status_code": resp.StatusCode,
 				"raw_body":    string(respBody),
 			})
-			// Handle rate limit errors with Retry-After header
-			if resp.StatusCode == 429 {
-				retryAfter := resp.Header.Get("Retry-After")
-				return nil, &RateLimitError{
-					StatusCode: resp.StatusCode,
-					Message:    string(respBody),
-					RetryAfter: retryAfter,
-				}
-			}
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+			apiErr.ErrorMessage = string(respBody)
+		} else {
+			tflog.Error(ctx, "API Error", map[string]interface{}{
+				"status_code": resp.StatusCode,
+				"error":       errResp.Error,
+				"request_id":  apiErr.RequestID,
+			})
+			apiErr.ErrorMessage = errResp.Error
+			apiErr.Message = errResp.Message
 		}
-		tflog.Error(ctx, "API Error", map[string]interface{}{
-			"status_code": resp.StatusCode,
-			"error":       errResp.Error,
-		})
+
 		// Handle rate limit errors with Retry-After header
-		if resp.StatusCode == 429 {
-			retryAfter := resp.Header.Get("Retry-After")
+		if resp.StatusCode == http.StatusTooManyRequests {
 			return nil, &RateLimitError{
 				StatusCode: resp.StatusCode,
-				Message:    errResp.Error,
-				RetryAfter: retryAfter,
+				Message:    apiErr.ErrorMessage,
+				RetryAfter: resp.Header.Get("Retry-After"),
+				Err:        apiErr,
 			}
 		}
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, errResp.Error)
+		return nil, apiErr
 	}
 
 	return respBody, nil
@@ -301,8 +377,8 @@ func parseRetryAfter(retryAfter string) int {
 // OIDC Client methods
 
 // CreateClient creates a new OIDC client
-func (c *Client) CreateClient(createReq *OIDCClientCreateRequest) (*OIDCClient, error) {
-	body, err := c.doRequest("POST", "/api/oidc/clients", createReq)
+func (c *Client) CreateClient(ctx context.Context, createReq *OIDCClientCreateRequest) (*OIDCClient, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/oidc/clients", createReq)
 	if err != nil {
 		return nil, err
 	}
@@ -316,8 +392,8 @@ func (c *Client) CreateClient(createReq *OIDCClientCreateRequest) (*OIDCClient,
 }
 
 // GetClient retrieves an OIDC client by ID
-func (c *Client) GetClient(clientID string) (*OIDCClient, error) {
-	body, err := c.doRequest("GET", fmt.Sprintf("/api/oidc/clients/%s", clientID), nil)
+func (c *Client) GetClient(ctx context.Context, clientID string) (*OIDCClient, error) {
+	body, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/oidc/clients/%s", clientID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -331,8 +407,8 @@ func (c *Client) GetClient(clientID string) (*OIDCClient, error) {
 }
 
 // UpdateClient updates an existing OIDC client
-func (c *Client) UpdateClient(clientID string, updateReq *OIDCClientCreateRequest) (*OIDCClient, error) {
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/oidc/clients/%s", clientID), updateReq)
+func (c *Client) UpdateClient(ctx context.Context, clientID string, updateReq *OIDCClientCreateRequest) (*OIDCClient, error) {
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/oidc/clients/%s", clientID), updateReq)
 	if err != nil {
 		return nil, err
 	}
@@ -346,36 +422,136 @@ func (c *Client) UpdateClient(clientID string, updateReq *OIDCClientCreateReques
 }
 
 // DeleteClient deletes an OIDC client
-func (c *Client) DeleteClient(clientID string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/oidc/clients/%s", clientID), nil)
+func (c *Client) DeleteClient(ctx context.Context, clientID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/oidc/clients/%s", clientID), nil)
 	return err
 }
 
-// ListClients retrieves all OIDC clients
-func (c *Client) ListClients() (*PaginatedResponse[OIDCClient], error) {
-	body, err := c.doRequest("GET", "/api/oidc/clients", nil)
-	if err != nil {
-		return nil, err
-	}
+// ListClients retrieves all OIDC clients, following pagination until every page has
+// been fetched.
+func (c *Client) ListClients(ctx context.Context) (*PaginatedResponse[OIDCClient], error) {
+	return listAll[OIDCClient](ctx, c, "/api/oidc/clients")
+}
 
-	var result PaginatedResponse[OIDCClient]
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+// ListClientsPage retrieves a single page of OIDC clients. Pages are 1-indexed.
+func (c *Client) ListClientsPage(ctx context.Context, page int) (*PaginatedResponse[OIDCClient], error) {
+	return getPage[OIDCClient](ctx, c, "/api/oidc/clients", page)
+}
+
+// IterClients returns an iterator over all OIDC clients. Pages are fetched lazily,
+// so breaking out of the loop early avoids requesting the remaining pages.
+func (c *Client) IterClients(ctx context.Context) iter.Seq2[OIDCClient, error] {
+	return paginate[OIDCClient](ctx, c, "/api/oidc/clients")
+}
+
+// UpdateClientAllowedUserGroups updates the allowed user groups for an OIDC
+// client. It is serialized with AddClientAllowedUserGroup and
+// RemoveClientAllowedUserGroup for the same client.
+func (c *Client) UpdateClientAllowedUserGroups(ctx context.Context, clientID string, groupIDs []string) error {
+	unlock, err := c.locks.lock(ctx, "client-groups/"+clientID)
+	if err != nil {
+		return err
 	}
+	defer unlock()
 
-	return &result, nil
+	return c.updateClientAllowedUserGroups(ctx, clientID, groupIDs)
 }
 
-// UpdateClientAllowedUserGroups updates the allowed user groups for an OIDC client
-func (c *Client) UpdateClientAllowedUserGroups(clientID string, groupIDs []string) error {
+// updateClientAllowedUserGroups is UpdateClientAllowedUserGroups for callers
+// that hold the client's lock.
+func (c *Client) updateClientAllowedUserGroups(ctx context.Context, clientID string, groupIDs []string) error {
 	req := UpdateAllowedUserGroupsRequest{UserGroupIDs: groupIDs}
-	_, err := c.doRequest("PUT", fmt.Sprintf("/api/oidc/clients/%s/allowed-user-groups", clientID), req)
+	_, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/oidc/clients/%s/allowed-user-groups", clientID), req)
 	return err
 }
 
+// AddClientAllowedUserGroup allows a user group to use an OIDC client and
+// keeps the groups already allowed. The client is restricted to its allowed
+// groups afterwards.
+func (c *Client) AddClientAllowedUserGroup(ctx context.Context, clientID, groupID string) error {
+	return c.setClientAllowedUserGroup(ctx, clientID, groupID, true)
+}
+
+// RemoveClientAllowedUserGroup stops a user group from using an OIDC client
+// and keeps the other allowed groups. The client stays restricted when no
+// allowed group remains, so removing the last group never opens the client to
+// all users.
+func (c *Client) RemoveClientAllowedUserGroup(ctx context.Context, clientID, groupID string) error {
+	return c.setClientAllowedUserGroup(ctx, clientID, groupID, false)
+}
+
+// setClientAllowedUserGroup reads the allowed groups of a client and writes
+// them back with groupID added or removed, then makes the client restricted if
+// a group was added. Changes to the same client are serialized to avoid losing
+// concurrent updates.
+func (c *Client) setClientAllowedUserGroup(ctx context.Context, clientID, groupID string, allowed bool) error {
+	unlock, err := c.locks.lock(ctx, "client-groups/"+clientID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	oidcClient, err := c.GetClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	groupIDs := make([]string, 0, len(oidcClient.AllowedUserGroups)+1)
+	isAllowed := false
+	for _, group := range oidcClient.AllowedUserGroups {
+		if group.ID == groupID {
+			isAllowed = true
+			continue
+		}
+		groupIDs = append(groupIDs, group.ID)
+	}
+	if allowed {
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	if isAllowed != allowed {
+		if err := c.updateClientAllowedUserGroups(ctx, clientID, groupIDs); err != nil {
+			return err
+		}
+	}
+
+	// The restriction is never lifted here: whether the client was open to
+	// all users before its first group was granted is not known.
+	if !allowed || oidcClient.IsGroupRestricted {
+		return nil
+	}
+	updateReq := oidcClient.updateRequest()
+	updateReq.IsGroupRestricted = true
+	_, err = c.UpdateClient(ctx, clientID, updateReq)
+	return err
+}
+
+// updateRequest returns a request that updates the client to its current
+// settings, for changing a single setting through the full update endpoint.
+func (oc *OIDCClient) updateRequest() *OIDCClientCreateRequest {
+	req := &OIDCClientCreateRequest{
+		Name:                     oc.Name,
+		CallbackURLs:             oc.CallbackURLs,
+		LogoutCallbackURLs:       oc.LogoutCallbackURLs,
+		IsPublic:                 oc.IsPublic,
+		RequiresReauthentication: oc.RequiresReauthentication,
+		PkceEnabled:              oc.PkceEnabled,
+		IsGroupRestricted:        oc.IsGroupRestricted,
+		Credentials:              oc.Credentials,
+	}
+	if oc.RequiresPushedAuthorizationRequests != nil {
+		req.RequiresPushedAuthorizationRequests = *oc.RequiresPushedAuthorizationRequests
+	}
+	if oc.LaunchURL != "" {
+		launchURL := oc.LaunchURL
+		req.LaunchURL = &launchURL
+	}
+	return req
+}
+
 // GenerateClientSecret generates a new client secret for an OIDC client
-func (c *Client) GenerateClientSecret(clientID string) (string, error) {
-	body, err := c.doRequest("POST", fmt.Sprintf("/api/oidc/clients/%s/secret", clientID), nil)
+func (c *Client) GenerateClientSecret(ctx context.Context, clientID string) (string, error) {
+	body, err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/oidc/clients/%s/secret", clientID), nil)
 	if err != nil {
 		return "", err
 	}
@@ -390,11 +566,33 @@ func (c *Client) GenerateClientSecret(clientID string) (string, error) {
 	return result.Secret, nil
 }
 
+// UploadClientLogo uploads the light or dark logo of an OIDC client, replacing
+// any existing one. The file name's extension tells Pocket-ID the image type.
+func (c *Client) UploadClientLogo(ctx context.Context, clientID string, light bool, fileName string, content []byte) error {
+	body, err := newMultipartBody("file", fileName, content)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, "POST", fmt.Sprintf("/api/oidc/clients/%s/logo?light=%t", clientID, light), body)
+	return err
+}
+
+// GetClientLogo downloads the light or dark logo of an OIDC client.
+func (c *Client) GetClientLogo(ctx context.Context, clientID string, light bool) ([]byte, error) {
+	return c.doRequest(ctx, "GET", fmt.Sprintf("/api/oidc/clients/%s/logo?light=%t", clientID, light), nil)
+}
+
+// DeleteClientLogo deletes the light or dark logo of an OIDC client
+func (c *Client) DeleteClientLogo(ctx context.Context, clientID string, light bool) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/oidc/clients/%s/logo?light=%t", clientID, light), nil)
+	return err
+}
+
 // User methods
 
 // CreateUser creates a new user
-func (c *Client) CreateUser(user *UserCreateRequest) (*User, error) {
-	body, err := c.doRequest("POST", "/api/users", user)
+func (c *Client) CreateUser(ctx context.Context, user *UserCreateRequest) (*User, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/users", user)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +606,8 @@ func (c *Client) CreateUser(user *UserCreateRequest) (*User, error) {
 }
 
 // GetUser retrieves a user by ID
-func (c *Client) GetUser(userID string) (*User, error) {
-	body, err := c.doRequest("GET", fmt.Sprintf("/api/users/%s", userID), nil)
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	body, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/users/%s", userID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -423,8 +621,8 @@ func (c *Client) GetUser(userID string) (*User, error) {
 }
 
 // UpdateUser updates an existing user
-func (c *Client) UpdateUser(userID string, user *UserCreateRequest) (*User, error) {
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/users/%s", userID), user)
+func (c *Client) UpdateUser(ctx context.Context, userID string, user *UserCreateRequest) (*User, error) {
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/users/%s", userID), user)
 	if err != nil {
 		return nil, err
 	}
@@ -438,44 +636,125 @@ func (c *Client) UpdateUser(userID string, user *UserCreateRequest) (*User, erro
 }
 
 // DeleteUser deletes a user
-func (c *Client) DeleteUser(userID string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/users/%s", userID), nil)
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/users/%s", userID), nil)
 	return err
 }
 
-// ListUsers retrieves all users
-func (c *Client) ListUsers() (*PaginatedResponse[User], error) {
-	body, err := c.doRequest("GET", "/api/users", nil)
-	if err != nil {
-		return nil, err
-	}
+// ListUsers retrieves all users, following pagination until every page has
+// been fetched.
+func (c *Client) ListUsers(ctx context.Context) (*PaginatedResponse[User], error) {
+	return listAll[User](ctx, c, "/api/users")
+}
 
-	var result PaginatedResponse[User]
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+// ListUsersPage retrieves a single page of users. Pages are 1-indexed.
+func (c *Client) ListUsersPage(ctx context.Context, page int) (*PaginatedResponse[User], error) {
+	return getPage[User](ctx, c, "/api/users", page)
+}
+
+// IterUsers returns an iterator over all users. Pages are fetched lazily,
+// so breaking out of the loop early avoids requesting the remaining pages.
+func (c *Client) IterUsers(ctx context.Context) iter.Seq2[User, error] {
+	return paginate[User](ctx, c, "/api/users")
+}
+
+// UpdateUserGroups updates the groups a user belongs to. It is serialized with
+// AddUserToGroup, RemoveUserFromGroup and UpdateUserGroupUsers for the same
+// user.
+func (c *Client) UpdateUserGroups(ctx context.Context, userID string, groupIDs []string) error {
+	unlock, err := c.locks.lock(ctx, userGroupsLockKey(userID))
+	if err != nil {
+		return err
 	}
+	defer unlock()
 
-	return &result, nil
+	return c.updateUserGroups(ctx, userID, groupIDs)
 }
 
-// UpdateUserGroups updates the groups a user belongs to
-func (c *Client) UpdateUserGroups(userID string, groupIDs []string) error {
+// updateUserGroups is UpdateUserGroups for callers that hold the user's lock.
+func (c *Client) updateUserGroups(ctx context.Context, userID string, groupIDs []string) error {
 	// Ensure groupIDs is never nil to serialize as empty array instead of null
 	if groupIDs == nil {
 		groupIDs = []string{}
 	}
 	req := UpdateUserGroupsRequest{UserGroupIDs: groupIDs}
-	_, err := c.doRequest("PUT", fmt.Sprintf("/api/users/%s/user-groups", userID), req)
+	_, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/users/%s/user-groups", userID), req)
 	return err
 }
 
+// AddUserToGroup adds a user to a group and keeps the user's other group
+// memberships. It does nothing when the user is already a member.
+func (c *Client) AddUserToGroup(ctx context.Context, userID, groupID string) error {
+	return c.setUserGroupMembership(ctx, userID, groupID, true)
+}
+
+// RemoveUserFromGroup removes a user from a group and keeps the user's other
+// group memberships. It does nothing when the user is not a member.
+func (c *Client) RemoveUserFromGroup(ctx context.Context, userID, groupID string) error {
+	return c.setUserGroupMembership(ctx, userID, groupID, false)
+}
+
+// setUserGroupMembership reads the groups of a user and writes them back with
+// groupID added or removed. The API only replaces the full list, so changes to
+// the same user are serialized to avoid losing concurrent updates.
+func (c *Client) setUserGroupMembership(ctx context.Context, userID, groupID string, member bool) error {
+	unlock, err := c.locks.lock(ctx, userGroupsLockKey(userID))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	groupIDs := make([]string, 0, len(user.UserGroups)+1)
+	isMember := false
+	for _, group := range user.UserGroups {
+		if group.ID == groupID {
+			isMember = true
+			continue
+		}
+		groupIDs = append(groupIDs, group.ID)
+	}
+	if isMember == member {
+		return nil
+	}
+	if member {
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	return c.updateUserGroups(ctx, userID, groupIDs)
+}
+
+// userGroupsLockKey returns the lock key that serializes changes to the group
+// memberships of a user.
+func userGroupsLockKey(userID string) string {
+	return "user-groups/" + userID
+}
+
 // UpdateUserCustomClaims replaces all custom claims for a user. The API
-// performs a full replace: claims not present in the list are removed.
-func (c *Client) UpdateUserCustomClaims(userID string, claims []CustomClaim) ([]CustomClaim, error) {
+// performs a full replace: claims not present in the list are removed. It is
+// serialized with SetUserCustomClaim and DeleteUserCustomClaim for the same
+// user.
+func (c *Client) UpdateUserCustomClaims(ctx context.Context, userID string, claims []CustomClaim) ([]CustomClaim, error) {
+	unlock, err := c.locks.lock(ctx, "user-claims/"+userID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return c.updateUserCustomClaims(ctx, userID, claims)
+}
+
+// updateUserCustomClaims is UpdateUserCustomClaims for callers that hold the
+// user's lock.
+func (c *Client) updateUserCustomClaims(ctx context.Context, userID string, claims []CustomClaim) ([]CustomClaim, error) {
 	if claims == nil {
 		claims = []CustomClaim{}
 	}
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/custom-claims/user/%s", userID), claims)
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/custom-claims/user/%s", userID), claims)
 	if err != nil {
 		return nil, err
 	}
@@ -488,11 +767,73 @@ func (c *Client) UpdateUserCustomClaims(userID string, claims []CustomClaim) ([]
 	return result, nil
 }
 
+// SetUserCustomClaim sets a single custom claim of a user and keeps the
+// user's other claims.
+func (c *Client) SetUserCustomClaim(ctx context.Context, userID, key, value string) error {
+	return c.setUserCustomClaim(ctx, userID, key, &value)
+}
+
+// DeleteUserCustomClaim removes a single custom claim of a user and keeps the
+// user's other claims. It does nothing when the user has no such claim.
+func (c *Client) DeleteUserCustomClaim(ctx context.Context, userID, key string) error {
+	return c.setUserCustomClaim(ctx, userID, key, nil)
+}
+
+// setUserCustomClaim reads the custom claims of a user and writes them back
+// with the claim set, or removed when value is nil. The API only replaces the
+// full list, so changes to the same user are serialized to avoid losing
+// concurrent updates.
+func (c *Client) setUserCustomClaim(ctx context.Context, userID, key string, value *string) error {
+	unlock, err := c.locks.lock(ctx, "user-claims/"+userID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	claims, changed := withCustomClaim(user.CustomClaims, key, value)
+	if !changed {
+		return nil
+	}
+
+	_, err = c.updateUserCustomClaims(ctx, userID, claims)
+	return err
+}
+
+// UploadUserProfilePicture replaces the profile picture of a user. The file
+// name's extension tells Pocket-ID the image type.
+func (c *Client) UploadUserProfilePicture(ctx context.Context, userID, fileName string, content []byte) error {
+	body, err := newMultipartBody("file", fileName, content)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, "PUT", fmt.Sprintf("/api/users/%s/profile-picture", userID), body)
+	return err
+}
+
+// GetUserProfilePicture downloads the profile picture of a user. Pocket-ID
+// converts uploaded pictures to PNG and generates one from the user's initials
+// when none has been uploaded.
+func (c *Client) GetUserProfilePicture(ctx context.Context, userID string) ([]byte, error) {
+	return c.doRequest(ctx, "GET", fmt.Sprintf("/api/users/%s/profile-picture.png", userID), nil)
+}
+
+// ResetUserProfilePicture removes the uploaded profile picture of a user, so
+// the picture generated from the user's initials is used again.
+func (c *Client) ResetUserProfilePicture(ctx context.Context, userID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/users/%s/profile-picture", userID), nil)
+	return err
+}
+
 // User Group methods
 
 // CreateUserGroup creates a new user group
-func (c *Client) CreateUserGroup(group *UserGroupCreateRequest) (*UserGroup, error) {
-	body, err := c.doRequest("POST", "/api/user-groups", group)
+func (c *Client) CreateUserGroup(ctx context.Context, group *UserGroupCreateRequest) (*UserGroup, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/user-groups", group)
 	if err != nil {
 		return nil, err
 	}
@@ -506,8 +847,8 @@ func (c *Client) CreateUserGroup(group *UserGroupCreateRequest) (*UserGroup, err
 }
 
 // GetUserGroup retrieves a user group by ID
-func (c *Client) GetUserGroup(groupID string) (*UserGroup, error) {
-	body, err := c.doRequest("GET", fmt.Sprintf("/api/user-groups/%s", groupID), nil)
+func (c *Client) GetUserGroup(ctx context.Context, groupID string) (*UserGroup, error) {
+	body, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/user-groups/%s", groupID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -521,8 +862,8 @@ func (c *Client) GetUserGroup(groupID string) (*UserGroup, error) {
 }
 
 // UpdateUserGroup updates an existing user group
-func (c *Client) UpdateUserGroup(groupID string, group *UserGroupCreateRequest) (*UserGroup, error) {
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/user-groups/%s", groupID), group)
+func (c *Client) UpdateUserGroup(ctx context.Context, groupID string, group *UserGroupCreateRequest) (*UserGroup, error) {
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/user-groups/%s", groupID), group)
 	if err != nil {
 		return nil, err
 	}
@@ -536,33 +877,101 @@ func (c *Client) UpdateUserGroup(groupID string, group *UserGroupCreateRequest)
 }
 
 // DeleteUserGroup deletes a user group
-func (c *Client) DeleteUserGroup(groupID string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/user-groups/%s", groupID), nil)
+func (c *Client) DeleteUserGroup(ctx context.Context, groupID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/user-groups/%s", groupID), nil)
 	return err
 }
 
-// ListUserGroups retrieves all user groups
-func (c *Client) ListUserGroups() (*PaginatedResponse[UserGroup], error) {
-	body, err := c.doRequest("GET", "/api/user-groups", nil)
-	if err != nil {
-		return nil, err
-	}
+// UpdateUserGroupUsers replaces the members of a user group. Users not in the
+// list are removed from the group. The change is serialized with
+// AddUserToGroup, RemoveUserFromGroup and UpdateUserGroups for every user that
+// is or becomes a member.
+func (c *Client) UpdateUserGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
+	// Ensure userIDs is never nil to serialize as empty array instead of null
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+
+	// The affected users are only known after reading the current members,
+	// which can change until their locks are held. Read them again under the
+	// locks and start over if a new member appeared in between.
+	locked := map[string]bool{}
+	for _, userID := range userIDs {
+		locked[userID] = true
+	}
+	for {
+		group, err := c.GetUserGroup(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		for _, user := range group.Users {
+			locked[user.ID] = true
+		}
 
-	var result PaginatedResponse[UserGroup]
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+		keys := make([]string, 0, len(locked))
+		for userID := range locked {
+			keys = append(keys, userGroupsLockKey(userID))
+		}
+		unlock, err := c.locks.lockAll(ctx, keys)
+		if err != nil {
+			return err
+		}
+
+		group, err = c.GetUserGroup(ctx, groupID)
+		if err != nil {
+			unlock()
+			return err
+		}
+		if slices.ContainsFunc(group.Users, func(user User) bool { return !locked[user.ID] }) {
+			unlock()
+			continue
+		}
+
+		req := UpdateUserGroupUsersRequest{UserIDs: userIDs}
+		_, err = c.doRequest(ctx, "PUT", fmt.Sprintf("/api/user-groups/%s/users", groupID), req)
+		unlock()
+		return err
 	}
+}
 
-	return &result, nil
+// ListUserGroups retrieves all user groups, following pagination until every page has
+// been fetched.
+func (c *Client) ListUserGroups(ctx context.Context) (*PaginatedResponse[UserGroup], error) {
+	return listAll[UserGroup](ctx, c, "/api/user-groups")
+}
+
+// ListUserGroupsPage retrieves a single page of user groups. Pages are 1-indexed.
+func (c *Client) ListUserGroupsPage(ctx context.Context, page int) (*PaginatedResponse[UserGroup], error) {
+	return getPage[UserGroup](ctx, c, "/api/user-groups", page)
+}
+
+// IterUserGroups returns an iterator over all user groups. Pages are fetched lazily,
+// so breaking out of the loop early avoids requesting the remaining pages.
+func (c *Client) IterUserGroups(ctx context.Context) iter.Seq2[UserGroup, error] {
+	return paginate[UserGroup](ctx, c, "/api/user-groups")
 }
 
 // UpdateGroupCustomClaims replaces all custom claims for a user group. The API
-// performs a full replace: claims not present in the list are removed.
-func (c *Client) UpdateGroupCustomClaims(groupID string, claims []CustomClaim) ([]CustomClaim, error) {
+// performs a full replace: claims not present in the list are removed. It is
+// serialized with SetGroupCustomClaim and DeleteGroupCustomClaim for the same
+// user group.
+func (c *Client) UpdateGroupCustomClaims(ctx context.Context, groupID string, claims []CustomClaim) ([]CustomClaim, error) {
+	unlock, err := c.locks.lock(ctx, "group-claims/"+groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return c.updateGroupCustomClaims(ctx, groupID, claims)
+}
+
+// updateGroupCustomClaims is UpdateGroupCustomClaims for callers that hold the
+// user group's lock.
+func (c *Client) updateGroupCustomClaims(ctx context.Context, groupID string, claims []CustomClaim) ([]CustomClaim, error) {
 	if claims == nil {
 		claims = []CustomClaim{}
 	}
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/custom-claims/user-group/%s", groupID), claims)
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/custom-claims/user-group/%s", groupID), claims)
 	if err != nil {
 		return nil, err
 	}
@@ -575,6 +984,70 @@ func (c *Client) UpdateGroupCustomClaims(groupID string, claims []CustomClaim) (
 	return result, nil
 }
 
+// SetGroupCustomClaim sets a single custom claim of a user group and keeps the
+// group's other claims.
+func (c *Client) SetGroupCustomClaim(ctx context.Context, groupID, key, value string) error {
+	return c.setGroupCustomClaim(ctx, groupID, key, &value)
+}
+
+// DeleteGroupCustomClaim removes a single custom claim of a user group and
+// keeps the group's other claims. It does nothing when the group has no such
+// claim.
+func (c *Client) DeleteGroupCustomClaim(ctx context.Context, groupID, key string) error {
+	return c.setGroupCustomClaim(ctx, groupID, key, nil)
+}
+
+// setGroupCustomClaim is the user group counterpart of setUserCustomClaim.
+func (c *Client) setGroupCustomClaim(ctx context.Context, groupID, key string, value *string) error {
+	unlock, err := c.locks.lock(ctx, "group-claims/"+groupID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	group, err := c.GetUserGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	claims, changed := withCustomClaim(group.CustomClaims, key, value)
+	if !changed {
+		return nil
+	}
+
+	_, err = c.updateGroupCustomClaims(ctx, groupID, claims)
+	return err
+}
+
+// withCustomClaim returns a copy of claims with the claim named key set to
+// value, or removed when value is nil, and whether anything changed.
+func withCustomClaim(claims []CustomClaim, key string, value *string) ([]CustomClaim, bool) {
+	result := make([]CustomClaim, 0, len(claims)+1)
+	changed := false
+	found := false
+	for _, claim := range claims {
+		if claim.Key != key {
+			result = append(result, claim)
+			continue
+		}
+		found = true
+		if value == nil {
+			changed = true
+			continue
+		}
+		if claim.Value != *value {
+			changed = true
+			claim.Value = *value
+		}
+		result = append(result, claim)
+	}
+	if !found && value != nil {
+		result = append(result, CustomClaim{Key: key, Value: *value})
+		changed = true
+	}
+	return result, changed
+}
+
 // OneTimeAccessToken represents a one-time access token. The create endpoint
 // only returns the token value; pocket-id v2 exposes no GET endpoint.
 type OneTimeAccessToken struct {
@@ -615,8 +1088,8 @@ func appConfigVariablesToConfig(vars []AppConfigVariable) *ApplicationConfig {
 
 // GetApplicationConfig retrieves the full application configuration, including
 // private values, from GET /api/application-configuration/all.
-func (c *Client) GetApplicationConfig() (*ApplicationConfig, error) {
-	body, err := c.doRequest("GET", "/api/application-configuration/all", nil)
+func (c *Client) GetApplicationConfig(ctx context.Context) (*ApplicationConfig, error) {
+	body, err := c.doRequest(ctx, "GET", "/api/application-configuration/all", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -632,8 +1105,8 @@ func (c *Client) GetApplicationConfig() (*ApplicationConfig, error) {
 // UpdateApplicationConfig updates the application configuration via
 // PUT /api/application-configuration. The provided config is sent in full; any
 // empty string field is reset to its server-side default by Pocket-ID.
-func (c *Client) UpdateApplicationConfig(cfg *ApplicationConfig) (*ApplicationConfig, error) {
-	body, err := c.doRequest("PUT", "/api/application-configuration", cfg)
+func (c *Client) UpdateApplicationConfig(ctx context.Context, cfg *ApplicationConfig) (*ApplicationConfig, error) {
+	body, err := c.doRequest(ctx, "PUT", "/api/application-configuration", cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -646,15 +1119,63 @@ func (c *Client) UpdateApplicationConfig(cfg *ApplicationConfig) (*ApplicationCo
 	return appConfigVariablesToConfig(vars), nil
 }
 
+// ApplicationImage identifies one of the branding images of a Pocket-ID
+// instance.
+type ApplicationImage string
+
+const (
+	ApplicationImageLogo       ApplicationImage = "logo"
+	ApplicationImageDarkLogo   ApplicationImage = "dark-logo"
+	ApplicationImageFavicon    ApplicationImage = "favicon"
+	ApplicationImageBackground ApplicationImage = "background"
+	ApplicationImageEmailLogo  ApplicationImage = "email"
+)
+
+// endpoint returns the API endpoint of the image. The light and dark logos
+// share an endpoint and are told apart by the light query parameter.
+func (i ApplicationImage) endpoint() string {
+	switch i {
+	case ApplicationImageLogo:
+		return "/api/application-images/logo?light=true"
+	case ApplicationImageDarkLogo:
+		return "/api/application-images/logo?light=false"
+	default:
+		return "/api/application-images/" + string(i)
+	}
+}
+
+// UploadApplicationImage replaces one of the branding images. The file name's
+// extension tells Pocket-ID the image type.
+func (c *Client) UploadApplicationImage(ctx context.Context, image ApplicationImage, fileName string, content []byte) error {
+	body, err := newMultipartBody("file", fileName, content)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, "PUT", image.endpoint(), body)
+	return err
+}
+
+// GetApplicationImage downloads one of the branding images. Pocket-ID serves
+// its default image when none has been uploaded.
+func (c *Client) GetApplicationImage(ctx context.Context, image ApplicationImage) ([]byte, error) {
+	return c.doRequest(ctx, "GET", image.endpoint(), nil)
+}
+
+// ResetApplicationImage restores the default of one of the branding images.
+// Servers that cannot reset the image answer with ErrNotFound.
+func (c *Client) ResetApplicationImage(ctx context.Context, image ApplicationImage) error {
+	_, err := c.doRequest(ctx, "DELETE", image.endpoint(), nil)
+	return err
+}
+
 // CreateOneTimeAccessToken creates a new one-time access token for a user
-func (c *Client) CreateOneTimeAccessToken(userID string, req *OneTimeAccessTokenRequest) (*OneTimeAccessToken, error) {
-	ctx := context.Background()
+func (c *Client) CreateOneTimeAccessToken(ctx context.Context, userID string, req *OneTimeAccessTokenRequest) (*OneTimeAccessToken, error) {
 	tflog.Debug(ctx, "CreateOneTimeAccessToken request", map[string]interface{}{
 		"user_id": userID,
 		"ttl":     req.TTL,
 	})
 
-	body, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/api/users/%s/one-time-access-token", userID), req)
+	body, err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/users/%s/one-time-access-token", userID), req)
 	if err != nil {
 		return nil, err
 	}
@@ -667,11 +1188,24 @@ func (c *Client) CreateOneTimeAccessToken(userID string, req *OneTimeAccessToken
 	return &token, nil
 }
 
+// SendOneTimeAccessEmail makes Pocket-ID email a one-time login link to a user.
+// The token is never returned to the caller. Pocket-ID rejects the request
+// unless SMTP is configured and emailOneTimeAccessAsAdminEnabled is set.
+func (c *Client) SendOneTimeAccessEmail(ctx context.Context, userID string, req *OneTimeAccessTokenRequest) error {
+	tflog.Debug(ctx, "SendOneTimeAccessEmail request", map[string]interface{}{
+		"user_id": userID,
+		"ttl":     req.TTL,
+	})
+
+	_, err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/users/%s/one-time-access-email", userID), req)
+	return err
+}
+
 // SCIM service provider methods
 
 // CreateScimServiceProvider creates a new SCIM service provider configuration.
-func (c *Client) CreateScimServiceProvider(req *ScimServiceProviderCreateRequest) (*ScimServiceProvider, error) {
-	body, err := c.doRequest("POST", "/api/scim/service-provider", req)
+func (c *Client) CreateScimServiceProvider(ctx context.Context, req *ScimServiceProviderCreateRequest) (*ScimServiceProvider, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/scim/service-provider", req)
 	if err != nil {
 		return nil, err
 	}
@@ -686,8 +1220,8 @@ func (c *Client) CreateScimServiceProvider(req *ScimServiceProviderCreateRequest
 
 // GetClientScimServiceProvider retrieves the SCIM service provider configuration
 // for an OIDC client. The token is returned decrypted.
-func (c *Client) GetClientScimServiceProvider(clientID string) (*ScimServiceProvider, error) {
-	body, err := c.doRequest("GET", fmt.Sprintf("/api/oidc/clients/%s/scim-service-provider", clientID), nil)
+func (c *Client) GetClientScimServiceProvider(ctx context.Context, clientID string) (*ScimServiceProvider, error) {
+	body, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/oidc/clients/%s/scim-service-provider", clientID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -701,8 +1235,8 @@ func (c *Client) GetClientScimServiceProvider(clientID string) (*ScimServiceProv
 }
 
 // UpdateScimServiceProvider updates an existing SCIM service provider configuration.
-func (c *Client) UpdateScimServiceProvider(id string, req *ScimServiceProviderCreateRequest) (*ScimServiceProvider, error) {
-	body, err := c.doRequest("PUT", fmt.Sprintf("/api/scim/service-provider/%s", id), req)
+func (c *Client) UpdateScimServiceProvider(ctx context.Context, id string, req *ScimServiceProviderCreateRequest) (*ScimServiceProvider, error) {
+	body, err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/scim/service-provider/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -716,14 +1250,243 @@ func (c *Client) UpdateScimServiceProvider(id string, req *ScimServiceProviderCr
 }
 
 // DeleteScimServiceProvider deletes a SCIM service provider configuration by ID.
-func (c *Client) DeleteScimServiceProvider(id string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/api/scim/service-provider/%s", id), nil)
+func (c *Client) DeleteScimServiceProvider(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/scim/service-provider/%s", id), nil)
 	return err
 }
 
+// API key methods
+
+// CreateAPIKey creates a new API key. The returned token cannot be retrieved
+// again.
+func (c *Client) CreateAPIKey(ctx context.Context, req *APIKeyCreateRequest) (*APIKeyCreateResponse, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/api-keys", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result APIKeyCreateResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAPIKey retrieves an API key by ID. Pocket-ID has no endpoint for a single
+// API key, so the key is looked up in the list. An *APIError matching
+// ErrNotFound is returned when no key has the ID.
+func (c *Client) GetAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	for apiKey, err := range c.IterAPIKeys(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		if apiKey.ID == apiKeyID {
+			return &apiKey, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode:   http.StatusNotFound,
+		Method:       "GET",
+		Endpoint:     "/api/api-keys",
+		ErrorMessage: fmt.Sprintf("API key %s not found", apiKeyID),
+	}
+}
+
+// DeleteAPIKey revokes an API key by ID.
+func (c *Client) DeleteAPIKey(ctx context.Context, apiKeyID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/api-keys/%s", apiKeyID), nil)
+	return err
+}
+
+// ListAPIKeys retrieves all API keys, following pagination until every page has
+// been fetched.
+func (c *Client) ListAPIKeys(ctx context.Context) (*PaginatedResponse[APIKey], error) {
+	return listAll[APIKey](ctx, c, "/api/api-keys")
+}
+
+// ListAPIKeysPage retrieves a single page of API keys. Pages are 1-indexed.
+func (c *Client) ListAPIKeysPage(ctx context.Context, page int) (*PaginatedResponse[APIKey], error) {
+	return getPage[APIKey](ctx, c, "/api/api-keys", page)
+}
+
+// IterAPIKeys returns an iterator over all API keys. Pages are fetched lazily,
+// so breaking out of the loop early avoids requesting the remaining pages.
+func (c *Client) IterAPIKeys(ctx context.Context) iter.Seq2[APIKey, error] {
+	return paginate[APIKey](ctx, c, "/api/api-keys")
+}
+
+// Signup token methods
+
+// CreateSignupToken creates a new signup token that lets people create an
+// account while open signups are disabled.
+func (c *Client) CreateSignupToken(ctx context.Context, req *SignupTokenCreateRequest) (*SignupToken, error) {
+	body, err := c.doRequest(ctx, "POST", "/api/signup-tokens", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SignupToken
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetSignupToken retrieves a signup token by ID. Pocket-ID has no endpoint for
+// a single signup token, so the token is looked up in the list. An *APIError
+// matching ErrNotFound is returned when no token has the ID.
+func (c *Client) GetSignupToken(ctx context.Context, tokenID string) (*SignupToken, error) {
+	for token, err := range c.IterSignupTokens(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		if token.ID == tokenID {
+			return &token, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode:   http.StatusNotFound,
+		Method:       "GET",
+		Endpoint:     "/api/signup-tokens",
+		ErrorMessage: fmt.Sprintf("signup token %s not found", tokenID),
+	}
+}
+
+// DeleteSignupToken deletes a signup token by ID.
+func (c *Client) DeleteSignupToken(ctx context.Context, tokenID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/signup-tokens/%s", tokenID), nil)
+	return err
+}
+
+// ListSignupTokens retrieves all signup tokens, following pagination until every page has
+// been fetched.
+func (c *Client) ListSignupTokens(ctx context.Context) (*PaginatedResponse[SignupToken], error) {
+	return listAll[SignupToken](ctx, c, "/api/signup-tokens")
+}
+
+// ListSignupTokensPage retrieves a single page of signup tokens. Pages are 1-indexed.
+func (c *Client) ListSignupTokensPage(ctx context.Context, page int) (*PaginatedResponse[SignupToken], error) {
+	return getPage[SignupToken](ctx, c, "/api/signup-tokens", page)
+}
+
+// IterSignupTokens returns an iterator over all signup tokens. Pages are fetched lazily,
+// so breaking out of the loop early avoids requesting the remaining pages.
+func (c *Client) IterSignupTokens(ctx context.Context) iter.Seq2[SignupToken, error] {
+	return paginate[SignupToken](ctx, c, "/api/signup-tokens")
+}
+
+// SignupURL returns the URL at which a signup token is redeemed.
+func (c *Client) SignupURL(token string) string {
+	return c.baseURL + "/st/" + url.PathEscape(token)
+}
+
 // SyncLdap triggers an LDAP synchronization. It returns an error if LDAP is not
 // enabled or the sync fails.
-func (c *Client) SyncLdap() error {
-	_, err := c.doRequest("POST", "/api/application-configuration/sync-ldap", nil)
+func (c *Client) SyncLdap(ctx context.Context) error {
+	_, err := c.doRequest(ctx, "POST", "/api/application-configuration/sync-ldap", nil)
+	return err
+}
+
+// SendTestEmail makes Pocket-ID send a test email to the user the API key
+// belongs to. The returned error carries the SMTP error reported by the server.
+func (c *Client) SendTestEmail(ctx context.Context) error {
+	_, err := c.doRequest(ctx, "POST", "/api/application-configuration/test-email", nil)
 	return err
 }
+
+// WebAuthn credential methods
+
+// ListUserWebauthnCredentials retrieves the passkeys a user has registered.
+func (c *Client) ListUserWebauthnCredentials(ctx context.Context, userID string) ([]WebauthnCredential, error) {
+	body, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/users/%s/webauthn-credentials", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []WebauthnCredential
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteUserWebauthnCredential revokes a passkey of a user. The user can no
+// longer sign in with it.
+func (c *Client) DeleteUserWebauthnCredential(ctx context.Context, userID, credentialID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/users/%s/webauthn-credentials/%s", userID, credentialID), nil)
+	return err
+}
+
+// Authorized client methods
+
+// ListUserAuthorizedClients retrieves all OIDC clients a user has authorized,
+// following pagination until every page has been fetched.
+func (c *Client) ListUserAuthorizedClients(ctx context.Context, userID string) (*PaginatedResponse[AuthorizedClient], error) {
+	return listAll[AuthorizedClient](ctx, c, fmt.Sprintf("/api/oidc/users/%s/authorized-clients", userID))
+}
+
+// ListUserAuthorizedClientsPage retrieves a single page of the OIDC clients a
+// user has authorized. Pages are 1-indexed.
+func (c *Client) ListUserAuthorizedClientsPage(ctx context.Context, userID string, page int) (*PaginatedResponse[AuthorizedClient], error) {
+	return getPage[AuthorizedClient](ctx, c, fmt.Sprintf("/api/oidc/users/%s/authorized-clients", userID), page)
+}
+
+// IterUserAuthorizedClients returns an iterator over all OIDC clients a user
+// has authorized. Pages are fetched lazily, so breaking out of the loop early
+// avoids requesting the remaining pages.
+func (c *Client) IterUserAuthorizedClients(ctx context.Context, userID string) iter.Seq2[AuthorizedClient, error] {
+	return paginate[AuthorizedClient](ctx, c, fmt.Sprintf("/api/oidc/users/%s/authorized-clients", userID))
+}
+
+// RevokeUserAuthorizedClient revokes the authorization a user granted to an
+// OIDC client. The client has to ask for consent again on the user's next
+// sign-in. An *APIError matching ErrNotFound is returned when the user has not
+// authorized the client.
+func (c *Client) RevokeUserAuthorizedClient(ctx context.Context, userID, clientID string) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/oidc/users/%s/authorized-clients/%s", userID, clientID), nil)
+	return err
+}
+
+// Audit log methods
+
+// auditLogsEndpoint returns the endpoint listing the audit log entries that
+// match filter, newest first.
+func auditLogsEndpoint(filter AuditLogFilter) string {
+	query := url.Values{}
+	query.Set("sort[column]", "createdAt")
+	query.Set("sort[direction]", "desc")
+	if filter.UserID != "" {
+		query.Set("filters[userId]", filter.UserID)
+	}
+	if filter.Event != "" {
+		query.Set("filters[event]", filter.Event)
+	}
+	if filter.ClientName != "" {
+		query.Set("filters[clientName]", filter.ClientName)
+	}
+	return "/api/audit-logs/all?" + query.Encode()
+}
+
+// ListAuditLogs retrieves all audit log entries matching filter, newest first,
+// following pagination until every page has been fetched.
+func (c *Client) ListAuditLogs(ctx context.Context, filter AuditLogFilter) (*PaginatedResponse[AuditLog], error) {
+	return listAll[AuditLog](ctx, c, auditLogsEndpoint(filter))
+}
+
+// ListAuditLogsPage retrieves a single page of the audit log entries matching
+// filter, newest first. Pages are 1-indexed.
+func (c *Client) ListAuditLogsPage(ctx context.Context, filter AuditLogFilter, page int) (*PaginatedResponse[AuditLog], error) {
+	return getPage[AuditLog](ctx, c, auditLogsEndpoint(filter), page)
+}
+
+// IterAuditLogs returns an iterator over the audit log entries matching
+// filter, newest first. Pages are fetched lazily, so breaking out of the loop
+// early avoids requesting the remaining pages.
+func (c *Client) IterAuditLogs(ctx context.Context, filter AuditLogFilter) iter.Seq2[AuditLog, error] {
+	return paginate[AuditLog](ctx, c, auditLogsEndpoint(filter))
+}
@@ -1,11 +1,13 @@
 package client_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -117,7 +119,7 @@ func TestClient_CreateClient(t *testing.T) {
 		LaunchURL:                &launchUrl,
 	}
 
-	result, err := c.CreateClient(createReq)
+	result, err := c.CreateClient(context.Background(), createReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedClient, result)
 }
@@ -149,7 +151,7 @@ func TestClient_GetClient(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetClient("test-client-id")
+	result, err := c.GetClient(context.Background(), "test-client-id")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedClient, result)
 }
@@ -196,7 +198,7 @@ func TestClient_UpdateClient(t *testing.T) {
 		LaunchURL:                &launchUrl,
 	}
 
-	result, err := c.UpdateClient("test-client-id", updateReq)
+	result, err := c.UpdateClient(context.Background(), "test-client-id", updateReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedClient, result)
 }
@@ -214,7 +216,7 @@ func TestClient_DeleteClient(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteClient("test-client-id")
+	err = c.DeleteClient(context.Background(), "test-client-id")
 	assert.NoError(t, err)
 }
 
@@ -252,7 +254,7 @@ func TestClient_ListClients(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListClients()
+	result, err := c.ListClients(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResponse, result)
 }
@@ -274,7 +276,7 @@ func TestClient_GenerateClientSecret(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	secret, err := c.GenerateClientSecret("test-client-id")
+	secret, err := c.GenerateClientSecret(context.Background(), "test-client-id")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedSecret, secret)
 }
@@ -332,7 +334,7 @@ func TestClient_ErrorHandling(t *testing.T) {
 			c, err := client.NewClient(server.URL, "test-token", false, 30)
 			require.NoError(t, err)
 
-			_, err = c.GetClient("test-client-id")
+			_, err = c.GetClient(context.Background(), "test-client-id")
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), tt.expectedErrMsg)
 		})
@@ -365,7 +367,7 @@ func TestClient_RetryLogic(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetClient("test-client-id")
+	result, err := c.GetClient(context.Background(), "test-client-id")
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, "test-client-id", result.ID)
@@ -387,7 +389,7 @@ func TestClient_RetryExhaustion(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	_, err = c.GetClient("test-client-id")
+	_, err = c.GetClient(context.Background(), "test-client-id")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "request failed after 4 attempts")
 	assert.Equal(t, 4, attempts, "Should have made 4 attempts (initial + 3 retries)")
@@ -408,7 +410,7 @@ func TestClient_NonRetryableError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	_, err = c.GetClient("test-client-id")
+	_, err = c.GetClient(context.Background(), "test-client-id")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 404: Not found")
 	assert.Equal(t, 1, attempts, "Should have made only 1 attempt (no retries for 404)")
@@ -417,7 +419,10 @@ func TestClient_NonRetryableError(t *testing.T) {
 func TestClient_ContextCancellation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Simulate slow response
-		time.Sleep(100 * time.Millisecond)
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
 		w.WriteHeader(http.StatusOK)
 	}))
 	defer server.Close()
@@ -425,11 +430,44 @@ func TestClient_ContextCancellation(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	// Note: The current client doesn't expose context-aware methods,
-	// but this test is prepared for when they are added
-	_, err = c.GetClient("test-client-id")
-	// The error might be a timeout or context cancellation depending on timing
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = c.GetClient(ctx, "test-client-id")
+	elapsed := time.Since(start)
+
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, elapsed, time.Second, "in-flight request should stop when the context is done")
+}
+
+func TestClient_ContextCancellationDuringBackoff(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		if _, err := fmt.Fprint(w, `{"error": "Service unavailable"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = c.GetClient(ctx, "test-client-id")
+	elapsed := time.Since(start)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "context cancelled during retry backoff")
+	assert.Equal(t, 1, attempts, "should not retry after the context is cancelled")
+	assert.Less(t, elapsed, time.Second, "backoff should stop when the context is cancelled")
 }
 
 // Test User-related methods
@@ -471,7 +509,7 @@ func TestClient_CreateUser(t *testing.T) {
 		LastName:  "User",
 	}
 
-	result, err := c.CreateUser(createReq)
+	result, err := c.CreateUser(context.Background(), createReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, result)
 }
@@ -509,7 +547,7 @@ func TestClient_CreateUserGroup(t *testing.T) {
 		FriendlyName: "Test Group",
 	}
 
-	result, err := c.CreateUserGroup(createReq)
+	result, err := c.CreateUserGroup(context.Background(), createReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedGroup, result)
 }
@@ -531,7 +569,7 @@ func TestClient_UpdateUserGroups(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.UpdateUserGroups("test-user-id", []string{"group1", "group2"})
+	err = c.UpdateUserGroups(context.Background(), "test-user-id", []string{"group1", "group2"})
 	assert.NoError(t, err)
 }
 
@@ -556,7 +594,7 @@ func TestClient_UpdateUserCustomClaims(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	claims, err := c.UpdateUserCustomClaims("test-user-id", []client.CustomClaim{
+	claims, err := c.UpdateUserCustomClaims(context.Background(), "test-user-id", []client.CustomClaim{
 		{Key: "department", Value: "engineering"},
 		{Key: "level", Value: "senior"},
 	})
@@ -582,7 +620,7 @@ func TestClient_UpdateUserCustomClaims_Clear(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	claims, err := c.UpdateUserCustomClaims("test-user-id", nil)
+	claims, err := c.UpdateUserCustomClaims(context.Background(), "test-user-id", nil)
 	require.NoError(t, err)
 	assert.Empty(t, claims)
 }
@@ -605,13 +643,133 @@ func TestClient_UpdateGroupCustomClaims(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	claims, err := c.UpdateGroupCustomClaims("test-group-id", []client.CustomClaim{
+	claims, err := c.UpdateGroupCustomClaims(context.Background(), "test-group-id", []client.CustomClaim{
 		{Key: "role", Value: "admin"},
 	})
 	require.NoError(t, err)
 	assert.Len(t, claims, 1)
 }
 
+// newCustomClaimsServer serves user-123 and group-123 sharing one list of
+// custom claims, initially the given ones, and returns a function reporting
+// the current claims.
+func newCustomClaimsServer(t *testing.T, initial ...client.CustomClaim) (*httptest.Server, func() []client.CustomClaim) {
+	t.Helper()
+
+	var mu sync.Mutex
+	claims := append([]client.CustomClaim{}, initial...)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/users/user-123":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(client.User{ID: "user-123", CustomClaims: claims})
+		case r.Method == "GET" && r.URL.Path == "/api/user-groups/group-123":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(client.UserGroup{ID: "group-123", CustomClaims: claims})
+		case r.Method == "PUT" && (r.URL.Path == "/api/custom-claims/user/user-123" || r.URL.Path == "/api/custom-claims/user-group/group-123"):
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&claims))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(claims)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []client.CustomClaim {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]client.CustomClaim{}, claims...)
+	}
+}
+
+func TestClient_SetUserCustomClaim(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []client.CustomClaim
+		want    []client.CustomClaim
+	}{
+		{
+			name:    "adds claim",
+			initial: []client.CustomClaim{{Key: "team", Value: "platform"}},
+			want:    []client.CustomClaim{{Key: "team", Value: "platform"}, {Key: "role", Value: "admin"}},
+		},
+		{
+			name:    "replaces value",
+			initial: []client.CustomClaim{{Key: "role", Value: "viewer"}, {Key: "team", Value: "platform"}},
+			want:    []client.CustomClaim{{Key: "role", Value: "admin"}, {Key: "team", Value: "platform"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, claims := newCustomClaimsServer(t, tt.initial...)
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			require.NoError(t, c.SetUserCustomClaim(context.Background(), "user-123", "role", "admin"))
+			assert.Equal(t, tt.want, claims())
+		})
+	}
+}
+
+func TestClient_DeleteUserCustomClaim(t *testing.T) {
+	server, claims := newCustomClaimsServer(t,
+		client.CustomClaim{Key: "role", Value: "admin"},
+		client.CustomClaim{Key: "team", Value: "platform"},
+	)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	require.NoError(t, c.DeleteUserCustomClaim(context.Background(), "user-123", "role"))
+	assert.Equal(t, []client.CustomClaim{{Key: "team", Value: "platform"}}, claims())
+
+	// Deleting a missing claim does nothing.
+	require.NoError(t, c.DeleteUserCustomClaim(context.Background(), "user-123", "role"))
+	assert.Equal(t, []client.CustomClaim{{Key: "team", Value: "platform"}}, claims())
+}
+
+func TestClient_SetGroupCustomClaim_Concurrent(t *testing.T) {
+	server, claims := newCustomClaimsServer(t)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.SetGroupCustomClaim(context.Background(), "group-123", fmt.Sprintf("claim-%d", i), "value"))
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, claims(), 10)
+}
+
+func TestClient_DeleteGroupCustomClaim(t *testing.T) {
+	server, claims := newCustomClaimsServer(t,
+		client.CustomClaim{Key: "role", Value: "admin"},
+		client.CustomClaim{Key: "team", Value: "platform"},
+	)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	require.NoError(t, c.DeleteGroupCustomClaim(context.Background(), "group-123", "team"))
+	assert.Equal(t, []client.CustomClaim{{Key: "role", Value: "admin"}}, claims())
+
+	err = c.DeleteGroupCustomClaim(context.Background(), "group-missing", "team")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
 func TestClient_UpdateClientAllowedUserGroups(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "PUT", r.Method)
@@ -629,10 +787,120 @@ func TestClient_UpdateClientAllowedUserGroups(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.UpdateClientAllowedUserGroups("test-client-id", []string{"group1", "group2"})
+	err = c.UpdateClientAllowedUserGroups(context.Background(), "test-client-id", []string{"group1", "group2"})
 	assert.NoError(t, err)
 }
 
+// allowedGroupsServer serves a single OIDC client whose allowed groups and
+// group restriction are kept in memory, and records the full updates it
+// receives.
+type allowedGroupsServer struct {
+	oidcClient client.OIDCClient
+	updates    []client.OIDCClientCreateRequest
+}
+
+func newAllowedGroupsServer(t *testing.T, restricted bool, groupIDs ...string) (*allowedGroupsServer, *client.Client) {
+	t.Helper()
+
+	par := true
+	s := &allowedGroupsServer{oidcClient: client.OIDCClient{
+		ID:                                  "client-123",
+		Name:                                "Shared App",
+		CallbackURLs:                        []string{"https://app.example.com/callback"},
+		LaunchURL:                           "https://app.example.com",
+		PkceEnabled:                         true,
+		RequiresPushedAuthorizationRequests: &par,
+		IsGroupRestricted:                   restricted,
+	}}
+	for _, id := range groupIDs {
+		s.oidcClient.AllowedUserGroups = append(s.oidcClient.AllowedUserGroups, client.UserGroup{ID: id})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/oidc/clients/client-123":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(s.oidcClient)
+		case r.Method == "PUT" && r.URL.Path == "/api/oidc/clients/client-123/allowed-user-groups":
+			var req client.UpdateAllowedUserGroupsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			s.oidcClient.AllowedUserGroups = nil
+			for _, id := range req.UserGroupIDs {
+				s.oidcClient.AllowedUserGroups = append(s.oidcClient.AllowedUserGroups, client.UserGroup{ID: id})
+			}
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "PUT" && r.URL.Path == "/api/oidc/clients/client-123":
+			var req client.OIDCClientCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			s.updates = append(s.updates, req)
+			s.oidcClient.IsGroupRestricted = req.IsGroupRestricted
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(s.oidcClient)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+	return s, c
+}
+
+func (s *allowedGroupsServer) groupIDs() []string {
+	var ids []string
+	for _, group := range s.oidcClient.AllowedUserGroups {
+		ids = append(ids, group.ID)
+	}
+	return ids
+}
+
+func TestClient_AddClientAllowedUserGroup(t *testing.T) {
+	s, c := newAllowedGroupsServer(t, false)
+
+	require.NoError(t, c.AddClientAllowedUserGroup(context.Background(), "client-123", "group-a"))
+
+	assert.Equal(t, []string{"group-a"}, s.groupIDs())
+	assert.True(t, s.oidcClient.IsGroupRestricted)
+
+	// The restriction is enabled through a full update that keeps the other
+	// settings of the client.
+	require.Len(t, s.updates, 1)
+	assert.Equal(t, "Shared App", s.updates[0].Name)
+	assert.Equal(t, []string{"https://app.example.com/callback"}, s.updates[0].CallbackURLs)
+	require.NotNil(t, s.updates[0].LaunchURL)
+	assert.Equal(t, "https://app.example.com", *s.updates[0].LaunchURL)
+	assert.True(t, s.updates[0].PkceEnabled)
+	assert.True(t, s.updates[0].RequiresPushedAuthorizationRequests)
+}
+
+func TestClient_AddClientAllowedUserGroup_KeepsOtherGroups(t *testing.T) {
+	s, c := newAllowedGroupsServer(t, true, "group-a")
+
+	ctx := context.Background()
+	require.NoError(t, c.AddClientAllowedUserGroup(ctx, "client-123", "group-b"))
+	require.NoError(t, c.AddClientAllowedUserGroup(ctx, "client-123", "group-b"))
+
+	assert.Equal(t, []string{"group-a", "group-b"}, s.groupIDs())
+	assert.Empty(t, s.updates, "an already restricted client needs no full update")
+}
+
+func TestClient_RemoveClientAllowedUserGroup(t *testing.T) {
+	s, c := newAllowedGroupsServer(t, true, "group-a", "group-b")
+
+	ctx := context.Background()
+	require.NoError(t, c.RemoveClientAllowedUserGroup(ctx, "client-123", "group-a"))
+	assert.Equal(t, []string{"group-b"}, s.groupIDs())
+	assert.True(t, s.oidcClient.IsGroupRestricted)
+
+	// Removing the last group keeps the client restricted rather than opening
+	// it to all users.
+	require.NoError(t, c.RemoveClientAllowedUserGroup(ctx, "client-123", "group-b"))
+	assert.Empty(t, s.groupIDs())
+	assert.True(t, s.oidcClient.IsGroupRestricted)
+	assert.Empty(t, s.updates, "removing a group needs no full update")
+}
+
 func TestClient_RateLimitHandling(t *testing.T) {
 	attempts := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -662,7 +930,7 @@ func TestClient_RateLimitHandling(t *testing.T) {
 	require.NoError(t, err)
 
 	start := time.Now()
-	result, err := c.GetClient("test-client-id")
+	result, err := c.GetClient(context.Background(), "test-client-id")
 	elapsed := time.Since(start)
 
 	assert.NoError(t, err)
@@ -701,7 +969,7 @@ func TestClient_RateLimitWithoutRetryAfter(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetClient("test-client-id")
+	result, err := c.GetClient(context.Background(), "test-client-id")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -732,7 +1000,7 @@ func TestClient_CreateOneTimeAccessToken(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	token, err := c.CreateOneTimeAccessToken("test-user-id", &client.OneTimeAccessTokenRequest{TTL: "15m"})
+	token, err := c.CreateOneTimeAccessToken(context.Background(), "test-user-id", &client.OneTimeAccessTokenRequest{TTL: "15m"})
 	assert.NoError(t, err)
 	assert.Equal(t, "test-token-123456", token.Token)
 }
@@ -756,11 +1024,69 @@ func TestClient_CreateOneTimeAccessToken_SendsTTL(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	token, err := c.CreateOneTimeAccessToken("test-user-id", &client.OneTimeAccessTokenRequest{TTL: "1h"})
+	token, err := c.CreateOneTimeAccessToken(context.Background(), "test-user-id", &client.OneTimeAccessTokenRequest{TTL: "1h"})
 	assert.NoError(t, err)
 	assert.Equal(t, "tok", token.Token)
 }
 
+func TestClient_SendOneTimeAccessEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/users/test-user-id/one-time-access-email", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "1h", body["ttl"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.SendOneTimeAccessEmail(context.Background(), "test-user-id", &client.OneTimeAccessTokenRequest{TTL: "1h"}))
+}
+
+func TestClient_SendTestEmail(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantError string
+	}{
+		{name: "sent", status: http.StatusNoContent},
+		{name: "smtp error", status: http.StatusInternalServerError, wantError: "failed to connect to SMTP server"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/api/application-configuration/test-email", r.URL.Path)
+
+				if tt.wantError == "" {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_ = json.NewEncoder(w).Encode(&client.ErrorResponse{Error: tt.wantError})
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			err = c.SendTestEmail(context.Background())
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantError, "the server's SMTP error must be surfaced")
+		})
+	}
+}
+
 func TestClient_CreateOneTimeAccessToken_Error(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -776,7 +1102,7 @@ func TestClient_CreateOneTimeAccessToken_Error(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	_, err = c.CreateOneTimeAccessToken("test-user-id", &client.OneTimeAccessTokenRequest{TTL: "1s"})
+	_, err = c.CreateOneTimeAccessToken(context.Background(), "test-user-id", &client.OneTimeAccessTokenRequest{TTL: "1s"})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid ttl")
 }
@@ -811,7 +1137,7 @@ func TestClient_CreateScimServiceProvider(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.CreateScimServiceProvider(&client.ScimServiceProviderCreateRequest{
+	result, err := c.CreateScimServiceProvider(context.Background(), &client.ScimServiceProviderCreateRequest{
 		Endpoint:     "https://scim.example.com/v2",
 		Token:        "secret-token",
 		OidcClientID: "client-123",
@@ -846,7 +1172,7 @@ func TestClient_GetClientScimServiceProvider(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetClientScimServiceProvider("client-123")
+	result, err := c.GetClientScimServiceProvider(context.Background(), "client-123")
 	assert.NoError(t, err)
 	assert.Equal(t, "scim-1", result.ID)
 	assert.Equal(t, "decrypted-token", result.Token)
@@ -876,7 +1202,7 @@ func TestClient_UpdateScimServiceProvider(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.UpdateScimServiceProvider("scim-1", &client.ScimServiceProviderCreateRequest{
+	result, err := c.UpdateScimServiceProvider(context.Background(), "scim-1", &client.ScimServiceProviderCreateRequest{
 		Endpoint:     "https://scim.example.com/v2/updated",
 		Token:        "new-token",
 		OidcClientID: "client-123",
@@ -896,6 +1222,6 @@ func TestClient_DeleteScimServiceProvider(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteScimServiceProvider("scim-1")
+	err = c.DeleteScimServiceProvider(context.Background(), "scim-1")
 	assert.NoError(t, err)
 }
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors describing the kind of an API failure. They are matched by
+// *APIError through errors.Is, so callers can branch on the error kind without
+// inspecting status codes:
+//
+//	if errors.Is(err, client.ErrNotFound) { ... }
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrConflict     = errors.New("resource conflict")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrValidation   = errors.New("validation failed")
+)
+
+// APIError is returned when the Pocket-ID API responds with a 4xx or 5xx
+// status code.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	// ErrorMessage is the "error" field of the response body, or the raw body
+	// when it is not a JSON error response.
+	ErrorMessage string
+	// Message is the optional "message" field of the response body.
+	Message   string
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTP %d: %s", e.StatusCode, e.ErrorMessage)
+	if e.Message != "" && e.Message != e.ErrorMessage {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+
+	var details []string
+	if e.Method != "" && e.Endpoint != "" {
+		details = append(details, e.Method+" "+e.Endpoint)
+	}
+	if e.RequestID != "" {
+		details = append(details, "request ID "+e.RequestID)
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(details, ", "))
+	}
+
+	return b.String()
+}
+
+// Is reports whether the error matches one of the sentinel errors of this
+// package, based on the HTTP status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// RateLimitError represents a 429 rate limit error with optional Retry-After information
+type RateLimitError struct {
+	StatusCode int
+	Message    string
+	RetryAfter string // Can be seconds or HTTP-date
+	// Err holds the full API error details of the 429 response.
+	Err *APIError
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter != "" {
+		return fmt.Sprintf("HTTP %d: %s (Retry-After: %s)", e.StatusCode, e.Message, e.RetryAfter)
+	}
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// Unwrap returns the underlying API error so errors.As can extract it.
+func (e *RateLimitError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// isRetryableError determines if an error is retryable
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Rate limits and transient server errors are retryable
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// A cancelled context is final. A cancelled or expired caller context is
+	// caught by the retry loop before it gets here, so an expired deadline is
+	// the HTTP client's own timeout: a slow server, which is worth retrying.
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	// Connection failures (refused, reset, DNS) are retryable
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	// Network timeouts, e.g. the HTTP client timeout or a TLS handshake timeout
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return false
+}
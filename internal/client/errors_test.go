@@ -1,9 +1,12 @@
 package client_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,7 +36,7 @@ func TestClient_CreateClient_UnmarshalError(t *testing.T) {
 		PkceEnabled:  true,
 	}
 
-	result, err := c.CreateClient(createReq)
+	result, err := c.CreateClient(context.Background(), createReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -59,7 +62,7 @@ func TestClient_UpdateClient_UnmarshalError(t *testing.T) {
 		PkceEnabled:  true,
 	}
 
-	result, err := c.UpdateClient("test-id", updateReq)
+	result, err := c.UpdateClient(context.Background(), "test-id", updateReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -78,7 +81,7 @@ func TestClient_ListClients_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListClients()
+	result, err := c.ListClients(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -97,7 +100,7 @@ func TestClient_GenerateClientSecret_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	_, err = c.GenerateClientSecret("test-id")
+	_, err = c.GenerateClientSecret(context.Background(), "test-id")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
 }
@@ -120,7 +123,7 @@ func TestClient_CreateUser_UnmarshalError(t *testing.T) {
 		Email:    "test@example.com",
 	}
 
-	result, err := c.CreateUser(createReq)
+	result, err := c.CreateUser(context.Background(), createReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -144,7 +147,7 @@ func TestClient_UpdateUser_UnmarshalError(t *testing.T) {
 		Email:    "test@example.com",
 	}
 
-	result, err := c.UpdateUser("test-id", updateReq)
+	result, err := c.UpdateUser(context.Background(), "test-id", updateReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -163,7 +166,7 @@ func TestClient_ListUsers_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUsers()
+	result, err := c.ListUsers(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -187,7 +190,7 @@ func TestClient_CreateUserGroup_UnmarshalError(t *testing.T) {
 		FriendlyName: "Test Group",
 	}
 
-	result, err := c.CreateUserGroup(createReq)
+	result, err := c.CreateUserGroup(context.Background(), createReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -211,7 +214,7 @@ func TestClient_UpdateUserGroup_UnmarshalError(t *testing.T) {
 		FriendlyName: "Test Group",
 	}
 
-	result, err := c.UpdateUserGroup("test-id", updateReq)
+	result, err := c.UpdateUserGroup(context.Background(), "test-id", updateReq)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -230,7 +233,7 @@ func TestClient_GetUser_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUser("test-id")
+	result, err := c.GetUser(context.Background(), "test-id")
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -249,7 +252,7 @@ func TestClient_GetUserGroup_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUserGroup("test-id")
+	result, err := c.GetUserGroup(context.Background(), "test-id")
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -268,7 +271,7 @@ func TestClient_ListUserGroups_UnmarshalError(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUserGroups()
+	result, err := c.ListUserGroups(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "error unmarshaling response")
@@ -299,7 +302,7 @@ func TestClient_RateLimitWithRetryAfterSeconds(t *testing.T) {
 	require.NoError(t, err)
 
 	start := time.Now()
-	result, err := c.GetClient("test-id")
+	result, err := c.GetClient(context.Background(), "test-id")
 	elapsed := time.Since(start)
 
 	assert.NoError(t, err)
@@ -322,12 +325,12 @@ func TestClient_RequestTimeout(t *testing.T) {
 	}))
 	defer server.Close()
 
-	// Create client with 1 second timeout
-	c, err := client.NewClient(server.URL, "test-token", false, 1)
+	// Create client with 1 second timeout and without retries
+	c, err := client.NewClient(server.URL, "test-token", false, 1, client.WithRetryPolicy(client.RetryPolicy{}))
 	require.NoError(t, err)
 
 	start := time.Now()
-	result, err := c.GetClient("test-id")
+	result, err := c.GetClient(context.Background(), "test-id")
 	elapsed := time.Since(start)
 
 	assert.Error(t, err)
@@ -338,6 +341,33 @@ func TestClient_RequestTimeout(t *testing.T) {
 		"Expected timeout around 1 second, got %v", elapsed)
 }
 
+// A slow server is transient, so the HTTP client's own timeout is retried.
+func TestClient_RequestTimeout_Retried(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			select {
+			case <-time.After(2 * time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprint(w, `{"id": "test-id"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 1, client.WithRetryPolicy(fastRetryPolicy))
+	require.NoError(t, err)
+
+	result, err := c.GetClient(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "test-id", result.ID)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
 // Test error response with empty body
 func TestClient_ErrorResponseEmptyBody(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -349,7 +379,7 @@ func TestClient_ErrorResponseEmptyBody(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetClient("test-id")
+	result, err := c.GetClient(context.Background(), "test-id")
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "HTTP 500")
@@ -404,7 +434,7 @@ func TestClient_NonRetryableErrors(t *testing.T) {
 			c, err := client.NewClient(server.URL, "test-token", false, 30)
 			require.NoError(t, err)
 
-			result, err := c.GetClient("test-id")
+			result, err := c.GetClient(context.Background(), "test-id")
 			assert.Error(t, err)
 			assert.Nil(t, result)
 			assert.Contains(t, err.Error(), fmt.Sprintf("HTTP %d", tc.statusCode))
@@ -412,3 +442,60 @@ func TestClient_NonRetryableErrors(t *testing.T) {
 		})
 	}
 }
+
+// Test that API errors are returned as *client.APIError and match the sentinel errors
+func TestClient_APIErrorSentinels(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		sentinel   error
+	}{
+		{name: "400 Bad Request", statusCode: http.StatusBadRequest, sentinel: client.ErrValidation},
+		{name: "401 Unauthorized", statusCode: http.StatusUnauthorized, sentinel: client.ErrUnauthorized},
+		{name: "403 Forbidden", statusCode: http.StatusForbidden, sentinel: client.ErrForbidden},
+		{name: "404 Not Found", statusCode: http.StatusNotFound, sentinel: client.ErrNotFound},
+		{name: "409 Conflict", statusCode: http.StatusConflict, sentinel: client.ErrConflict},
+		{name: "422 Unprocessable Entity", statusCode: http.StatusUnprocessableEntity, sentinel: client.ErrValidation},
+	}
+
+	sentinels := []error{
+		client.ErrNotFound,
+		client.ErrConflict,
+		client.ErrUnauthorized,
+		client.ErrForbidden,
+		client.ErrValidation,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.WriteHeader(tc.statusCode)
+				if _, err := fmt.Fprint(w, `{"error": "Something went wrong", "message": "More detail"}`); err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			_, err = c.GetUser(context.Background(), "test-id")
+			require.Error(t, err)
+
+			var apiErr *client.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tc.statusCode, apiErr.StatusCode)
+			assert.Equal(t, "GET", apiErr.Method)
+			assert.Equal(t, "/api/users/test-id", apiErr.Endpoint)
+			assert.Equal(t, "Something went wrong", apiErr.ErrorMessage)
+			assert.Equal(t, "More detail", apiErr.Message)
+			assert.Equal(t, "req-123", apiErr.RequestID)
+			assert.Contains(t, err.Error(), "request ID req-123")
+
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tc.sentinel, errors.Is(err, sentinel), "errors.Is(err, %v)", sentinel)
+			}
+		})
+	}
+}
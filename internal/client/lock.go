@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// keyedMutex serializes work per key. The client uses it for read-modify-write
+// updates, such as adding one group to a user's group list, so that concurrent
+// resources changing the same subject do not overwrite each other's changes.
+// Entries are reference counted and removed once nobody holds or waits for
+// them. The zero value is ready to use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is a mutex that can be waited on together with a context. The
+// buffered channel holds a value while the lock is held. refs counts the
+// holder and the waiters, and is guarded by keyedMutex.mu.
+type keyedLock struct {
+	ch   chan struct{}
+	refs int
+}
+
+// lock acquires the lock for key and returns the function that releases it.
+// It returns the context's error if ctx is done before the lock is acquired.
+func (km *keyedMutex) lock(ctx context.Context, key string) (func(), error) {
+	km.mu.Lock()
+	if km.locks == nil {
+		km.locks = map[string]*keyedLock{}
+	}
+	l, ok := km.locks[key]
+	if !ok {
+		l = &keyedLock{ch: make(chan struct{}, 1)}
+		km.locks[key] = l
+	}
+	l.refs++
+	km.mu.Unlock()
+
+	select {
+	case l.ch <- struct{}{}:
+	case <-ctx.Done():
+		km.release(key, l)
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-l.ch
+			km.release(key, l)
+		})
+	}, nil
+}
+
+// lockAll acquires the locks for all keys and returns the function that
+// releases them. Keys are locked in sorted order so that callers locking
+// overlapping sets cannot deadlock. If ctx is done before every lock is
+// acquired, the locks taken so far are released and the context's error is
+// returned.
+func (km *keyedMutex) lockAll(ctx context.Context, keys []string) (func(), error) {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	unlocks := make([]func(), 0, len(keys))
+	unlockAll := func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+	for _, key := range keys {
+		unlock, err := km.lock(ctx, key)
+		if err != nil {
+			unlockAll()
+			return nil, err
+		}
+		unlocks = append(unlocks, unlock)
+	}
+	return unlockAll, nil
+}
+
+// release drops one reference to l and removes its entry when none remain.
+func (km *keyedMutex) release(key string, l *keyedLock) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+	l.refs--
+	if l.refs == 0 {
+		delete(km.locks, key)
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutex_RemovesUnusedKeys(t *testing.T) {
+	var km keyedMutex
+	ctx := context.Background()
+
+	unlockA, err := km.lock(ctx, "a")
+	require.NoError(t, err)
+	unlockB, err := km.lock(ctx, "b")
+	require.NoError(t, err)
+	assert.Len(t, km.locks, 2)
+
+	unlockA()
+	assert.Len(t, km.locks, 1)
+	// Releasing twice must not drop the reference of another holder.
+	unlockA()
+	assert.Len(t, km.locks, 1)
+
+	unlockB()
+	assert.Empty(t, km.locks)
+}
+
+func TestKeyedMutex_ContextCancelled(t *testing.T) {
+	var km keyedMutex
+
+	unlock, err := km.lock(context.Background(), "a")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = km.lock(ctx, "a")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, km.locks["a"].refs)
+
+	unlock()
+	assert.Empty(t, km.locks)
+}
+
+func TestKeyedMutex_LockAll(t *testing.T) {
+	var km keyedMutex
+	ctx := context.Background()
+
+	unlock, err := km.lockAll(ctx, []string{"b", "a", "b"})
+	require.NoError(t, err)
+	assert.Len(t, km.locks, 2)
+
+	// A key held by lockAll cannot be taken until it is released, and keys
+	// taken before the failure are released again.
+	cancelled, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = km.lockAll(cancelled, []string{"c", "a"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, km.locks, 2)
+
+	unlock()
+	assert.Empty(t, km.locks)
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"slices"
+	"sync"
+)
+
+// MembershipClaims records the group memberships that pocketid_user.groups and
+// pocketid_group.members set authoritatively in the current plan. Both replace
+// complete lists, so when they disagree about whether a user is in a group,
+// every apply undoes the change made by the other.
+//
+// The claims live on the Client, so they last as long as the configured
+// provider and separate provider configurations do not see each other. A claim
+// is only compared against the claims recorded before it, so which resource
+// reports a conflict depends on the order in which Terraform plans them. The
+// zero value is ready to use.
+type MembershipClaims struct {
+	mu           sync.Mutex
+	userGroups   map[string]map[string]bool // user ID to group IDs
+	groupMembers map[string]map[string]bool // group ID to user IDs
+}
+
+// MembershipClaims returns the membership claims recorded for the client.
+func (c *Client) MembershipClaims() *MembershipClaims {
+	return &c.memberships
+}
+
+// ClaimUserGroups records the groups configured for a user and returns the
+// groups whose configured members disagree about the user, sorted by ID.
+func (m *MembershipClaims) ClaimUserGroups(userID string, groupIDs []string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.userGroups == nil {
+		m.userGroups = map[string]map[string]bool{}
+	}
+	groups := toSet(groupIDs)
+	m.userGroups[userID] = groups
+
+	var conflicts []string
+	for groupID, members := range m.groupMembers {
+		if members[userID] != groups[groupID] {
+			conflicts = append(conflicts, groupID)
+		}
+	}
+	slices.Sort(conflicts)
+	return conflicts
+}
+
+// ClaimGroupMembers records the members configured for a group and returns the
+// users whose configured groups disagree about the group, sorted by ID.
+func (m *MembershipClaims) ClaimGroupMembers(groupID string, userIDs []string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.groupMembers == nil {
+		m.groupMembers = map[string]map[string]bool{}
+	}
+	members := toSet(userIDs)
+	m.groupMembers[groupID] = members
+
+	var conflicts []string
+	for userID, groups := range m.userGroups {
+		if groups[groupID] != members[userID] {
+			conflicts = append(conflicts, userID)
+		}
+	}
+	slices.Sort(conflicts)
+	return conflicts
+}
+
+// toSet returns the values as a set.
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
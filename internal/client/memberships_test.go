@@ -0,0 +1,38 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestMembershipClaims(t *testing.T) {
+	claims := (&client.Client{}).MembershipClaims()
+
+	// Nothing to compare against yet.
+	assert.Empty(t, claims.ClaimUserGroups("user-1", []string{"group-a"}))
+	assert.Empty(t, claims.ClaimUserGroups("user-2", nil))
+
+	// Both sides agree that user-1 is in group-a and user-2 is not.
+	assert.Empty(t, claims.ClaimGroupMembers("group-a", []string{"user-1"}))
+
+	// group-b lists user-2, whose groups do not include it, and omits
+	// user-1, whose groups do not include it either.
+	assert.Equal(t, []string{"user-2"}, claims.ClaimGroupMembers("group-b", []string{"user-2"}))
+
+	// user-1 now lists group-b, which does not list user-1, and no longer
+	// lists group-a, which does.
+	assert.Equal(t, []string{"group-a", "group-b"}, claims.ClaimUserGroups("user-1", []string{"group-b"}))
+}
+
+func TestMembershipClaims_SeparatedByClient(t *testing.T) {
+	first := (&client.Client{}).MembershipClaims()
+	second := (&client.Client{}).MembershipClaims()
+	require.NotSame(t, first, second)
+
+	first.ClaimUserGroups("user-1", nil)
+	assert.Empty(t, second.ClaimGroupMembers("group-a", []string{"user-1"}))
+}
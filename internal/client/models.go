@@ -25,6 +25,7 @@ type OIDCClient struct {
 	ID                       string   `json:"id,omitempty"`
 	Name                     string   `json:"name"`
 	HasLogo                  bool     `json:"hasLogo,omitempty"`
+	HasDarkLogo              bool     `json:"hasDarkLogo,omitempty"`
 	CallbackURLs             []string `json:"callbackURLs"`
 	LogoutCallbackURLs       []string `json:"logoutCallbackURLs,omitempty"`
 	IsPublic                 bool     `json:"isPublic"`
@@ -113,6 +114,11 @@ type UpdateUserGroupsRequest struct {
 	UserGroupIDs []string `json:"userGroupIds"`
 }
 
+// UpdateUserGroupUsersRequest represents a request to update the members of a user group
+type UpdateUserGroupUsersRequest struct {
+	UserIDs []string `json:"userIds"`
+}
+
 // UserGroup represents a user group in Pocket-ID
 type UserGroup struct {
 	ID           string        `json:"id,omitempty"`
@@ -213,6 +219,87 @@ type APIKey struct {
 	ExpirationEmailSent bool   `json:"expirationEmailSent"`
 }
 
+// APIKeyCreateRequest represents a request to create an API key
+type APIKeyCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// APIKeyCreateResponse is returned when an API key is created. The token is
+// only ever returned in this response.
+type APIKeyCreateResponse struct {
+	APIKey APIKey `json:"apiKey"`
+	Token  string `json:"token"`
+}
+
+// SignupToken represents a signup token in Pocket-ID
+type SignupToken struct {
+	ID         string      `json:"id"`
+	Token      string      `json:"token"`
+	ExpiresAt  string      `json:"expiresAt"`
+	UsageLimit int64       `json:"usageLimit"`
+	UsageCount int64       `json:"usageCount"`
+	UserGroups []UserGroup `json:"userGroups,omitempty"`
+	CreatedAt  string      `json:"createdAt,omitempty"`
+}
+
+// SignupTokenCreateRequest represents a request to create a signup token.
+// The API expects a ttl (lifetime) as a duration string, e.g. "24h".
+type SignupTokenCreateRequest struct {
+	TTL          string   `json:"ttl"`
+	UsageLimit   int64    `json:"usageLimit"`
+	UserGroupIDs []string `json:"userGroupIds,omitempty"`
+}
+
+// WebauthnCredential represents a passkey registered by a user. AAGUID
+// identifies the authenticator model, and the backup flags tell whether the
+// passkey can be, and is, synced to other devices.
+type WebauthnCredential struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	CredentialID    string   `json:"credentialID"`
+	AttestationType string   `json:"attestationType,omitempty"`
+	AAGUID          string   `json:"aaguid,omitempty"`
+	Transport       []string `json:"transport,omitempty"`
+	BackupEligible  bool     `json:"backupEligible"`
+	BackupState     bool     `json:"backupState"`
+	CreatedAt       string   `json:"createdAt,omitempty"`
+	LastUsedAt      string   `json:"lastUsedAt,omitempty"`
+}
+
+// AuthorizedClient represents an OIDC client a user has authorized, with the
+// scopes the user consented to.
+type AuthorizedClient struct {
+	Scope      string             `json:"scope"`
+	Client     OIDCClientMetadata `json:"client"`
+	LastUsedAt string             `json:"lastUsedAt,omitempty"`
+}
+
+// AuditLog represents an entry of the Pocket-ID audit log. Device is the
+// browser and operating system derived from the user agent, and Data holds
+// event specific details such as the client name.
+type AuditLog struct {
+	ID        string            `json:"id"`
+	Event     string            `json:"event"`
+	IPAddress string            `json:"ipAddress"`
+	Country   string            `json:"country,omitempty"`
+	City      string            `json:"city,omitempty"`
+	Device    string            `json:"device,omitempty"`
+	UserID    string            `json:"userID"`
+	Username  string            `json:"username,omitempty"`
+	CreatedAt string            `json:"createdAt"`
+	Data      map[string]string `json:"data,omitempty"`
+}
+
+// AuditLogFilter narrows down the audit log entries listed. Empty fields do
+// not filter.
+type AuditLogFilter struct {
+	UserID     string
+	Event      string
+	ClientName string
+}
+
 // ScimServiceProvider represents a SCIM service provider configuration attached
 // to an OIDC client in Pocket-ID. The token is stored encrypted server-side but
 // is returned (decrypted) on read.
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the number of items requested per page by the list
+// methods when no page size has been configured.
+const DefaultPageSize = 100
+
+// pageEndpoint appends the Pocket-ID pagination query parameters to endpoint,
+// keeping any query parameters it already has, e.g. filters. It returns an
+// error when those cannot be parsed, as dropping them would drop the filters.
+func pageEndpoint(endpoint string, page, pageSize int) (string, error) {
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("invalid query in endpoint %q: %w", endpoint, err)
+	}
+	query.Set("pagination[page]", strconv.Itoa(page))
+	query.Set("pagination[limit]", strconv.Itoa(pageSize))
+	return path + "?" + query.Encode(), nil
+}
+
+// getPage retrieves a single page of a paginated list endpoint. Pages are
+// 1-indexed, matching the Pocket-ID API.
+func getPage[T any](ctx context.Context, c *Client, endpoint string, page int) (*PaginatedResponse[T], error) {
+	endpoint, err := pageEndpoint(endpoint, page, c.pageSize)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PaginatedResponse[T]
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// isLastPage reports whether resp is the final page of a listing. An empty
+// page also ends the listing so a server that misreports totalPages cannot
+// cause an endless loop.
+func isLastPage[T any](page int, resp *PaginatedResponse[T]) bool {
+	return page >= resp.Pagination.TotalPages || len(resp.Data) == 0
+}
+
+// listAll follows the pagination of a list endpoint and returns every item in
+// a single response. The pagination metadata is that of the last page fetched.
+func listAll[T any](ctx context.Context, c *Client, endpoint string) (*PaginatedResponse[T], error) {
+	result := &PaginatedResponse[T]{Data: []T{}}
+	for page := 1; ; page++ {
+		resp, err := getPage[T](ctx, c, endpoint, page)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Data = append(result.Data, resp.Data...)
+		result.Pagination = resp.Pagination
+
+		if isLastPage(page, resp) {
+			return result, nil
+		}
+	}
+}
+
+// paginate returns an iterator over every item of a list endpoint. Pages are
+// fetched lazily as the iteration advances, so a caller that stops early (for
+// example after finding a match) does not request the remaining pages. A
+// request error is yielded once, with a zero item, and ends the iteration.
+func paginate[T any](ctx context.Context, c *Client, endpoint string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 1; ; page++ {
+			resp, err := getPage[T](ctx, c, endpoint, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range resp.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if isLastPage(page, resp) {
+				return
+			}
+		}
+	}
+}
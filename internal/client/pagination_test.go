@@ -0,0 +1,164 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// newPaginatedUserServer serves totalUsers users in pages of the size
+// requested through the pagination[limit] query parameter. It records the
+// pages requested.
+func newPaginatedUserServer(t *testing.T, totalUsers int, requestedPages *[]int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/users", r.URL.Path)
+
+		page, err := strconv.Atoi(r.URL.Query().Get("pagination[page]"))
+		require.NoError(t, err)
+		limit, err := strconv.Atoi(r.URL.Query().Get("pagination[limit]"))
+		require.NoError(t, err)
+		*requestedPages = append(*requestedPages, page)
+
+		totalPages := (totalUsers + limit - 1) / limit
+		users := []client.User{}
+		for i := (page - 1) * limit; i < page*limit && i < totalUsers; i++ {
+			users = append(users, client.User{
+				ID:       fmt.Sprintf("user%d", i),
+				Username: fmt.Sprintf("user%d", i),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.PaginatedResponse[client.User]{
+			Data: users,
+			Pagination: client.PaginationInfo{
+				TotalPages:   totalPages,
+				TotalItems:   totalUsers,
+				CurrentPage:  page,
+				ItemsPerPage: limit,
+			},
+		}); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_ListUsers_FollowsPagination(t *testing.T) {
+	var pages []int
+	server := newPaginatedUserServer(t, 25, &pages)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithPageSize(10))
+	require.NoError(t, err)
+
+	result, err := c.ListUsers(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, result.Data, 25)
+	assert.Equal(t, "user0", result.Data[0].ID)
+	assert.Equal(t, "user24", result.Data[24].ID)
+	assert.Equal(t, 25, result.Pagination.TotalItems)
+	assert.Equal(t, []int{1, 2, 3}, pages)
+}
+
+func TestClient_ListUsers_DefaultPageSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("pagination[page]"))
+		assert.Equal(t, strconv.Itoa(client.DefaultPageSize), r.URL.Query().Get("pagination[limit]"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprint(w, `{"data": [], "pagination": {"totalPages": 0}}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithPageSize(0))
+	require.NoError(t, err)
+
+	result, err := c.ListUsers(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, result.Data)
+}
+
+func TestClient_ListUsersPage(t *testing.T) {
+	var pages []int
+	server := newPaginatedUserServer(t, 25, &pages)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithPageSize(10))
+	require.NoError(t, err)
+
+	result, err := c.ListUsersPage(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Len(t, result.Data, 5)
+	assert.Equal(t, 3, result.Pagination.CurrentPage)
+	assert.Equal(t, []int{3}, pages)
+}
+
+func TestClient_IterUsers_StopsEarly(t *testing.T) {
+	var pages []int
+	server := newPaginatedUserServer(t, 25, &pages)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithPageSize(10))
+	require.NoError(t, err)
+
+	var found *client.User
+	for user, err := range c.IterUsers(context.Background()) {
+		require.NoError(t, err)
+		if user.Username == "user12" {
+			found = &user
+			break
+		}
+	}
+
+	require.NotNil(t, found)
+	assert.Equal(t, "user12", found.ID)
+	assert.Equal(t, []int{1, 2}, pages, "should not fetch pages after the match")
+}
+
+func TestClient_IterUserGroups_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		if _, err := fmt.Fprint(w, `{"error": "Forbidden"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	var errs []error
+	for _, err := range c.IterUserGroups(context.Background()) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "HTTP 403")
+}
+
+// An endpoint whose query cannot be parsed is rejected rather than requested
+// without its query.
+func TestClient_ListPage_InvalidQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	_, err = c.ListUserAuthorizedClientsPage(context.Background(), "user?%zz", 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid query")
+}
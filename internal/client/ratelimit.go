@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the client to requestsPerSecond requests per second,
+// shared by every caller of the client. Requests are spread out evenly rather
+// than sent in bursts. Values lower than or equal to 0 disable the limit.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *Client) {
+		if requestsPerSecond > 0 {
+			c.rateLimiter = newTokenBucket(requestsPerSecond)
+		}
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests the client has in
+// flight at the same time. Values lower than 1 disable the limit.
+func WithMaxConcurrentRequests(limit int) Option {
+	return func(c *Client) {
+		if limit > 0 {
+			c.concurrency = make(chan struct{}, limit)
+		}
+	}
+}
+
+// tokenBucket is a rate limiter holding at most one token, refilled at a
+// fixed rate. Callers reserve a token and wait until it becomes available, so
+// concurrent callers are served one interval apart.
+type tokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(requestsPerSecond float64) *tokenBucket {
+	return &tokenBucket{
+		interval: time.Duration(float64(time.Second) / requestsPerSecond),
+		tokens:   1,
+		last:     time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before the
+// token may be used. The token count goes negative while reservations are
+// pending.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+	if b.tokens > 1 {
+		b.tokens = 1
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens * float64(b.interval))
+}
+
+// cancel returns a reserved token that will not be used.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// acquire waits for a free concurrency slot and for the rate limiter before a
+// request is sent. The returned function releases the slot and must be called
+// once the request has completed.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if c.concurrency != nil {
+		select {
+		case c.concurrency <- struct{}{}:
+			release = func() { <-c.concurrency }
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context cancelled while waiting for a request slot: %w", ctx.Err())
+		}
+	}
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			release()
+			return nil, fmt.Errorf("context cancelled while waiting for the rate limiter: %w", err)
+		}
+	}
+
+	return release, nil
+}
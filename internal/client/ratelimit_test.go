@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// newUserServer answers every request with a user after the given delay,
+// recording the highest number of requests it served concurrently.
+func newUserServer(t *testing.T, delay time.Duration, maxInFlight *int32) *httptest.Server {
+	var inFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			highest := atomic.LoadInt32(maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(maxInFlight, highest, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&client.User{ID: "test-id"}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	var maxInFlight int32
+	server := newUserServer(t, 0, &maxInFlight)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRateLimit(20))
+	require.NoError(t, err)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetUser(context.Background(), "test-id")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// The first request is sent immediately, the next four 50ms apart.
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
+
+func TestClient_RateLimit_ContextCancelled(t *testing.T) {
+	var maxInFlight int32
+	server := newUserServer(t, 0, &maxInFlight)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRateLimit(0.1))
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = c.GetUser(ctx, "test-id")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "rate limiter")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_MaxConcurrentRequests(t *testing.T) {
+	var maxInFlight int32
+	server := newUserServer(t, 20*time.Millisecond, &maxInFlight)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithMaxConcurrentRequests(2))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetUser(context.Background(), "test-id")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero
+	// disables retries.
+	MaxRetries int
+	// WaitMin is the backoff before the first retry. It doubles on every
+	// subsequent retry.
+	WaitMin time.Duration
+	// WaitMax caps the exponential backoff between two attempts.
+	WaitMax time.Duration
+	// Jitter randomizes each backoff between half and the full computed
+	// value, so parallel requests do not retry in lockstep.
+	Jitter bool
+	// Deadline bounds the total time spent retrying a request. A retry whose
+	// backoff would end after the deadline is not attempted. Zero means no
+	// deadline.
+	Deadline time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured:
+// three retries with a jittered exponential backoff of up to 1s, 2s and 4s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		WaitMin:    time.Second,
+		WaitMax:    30 * time.Second,
+		Jitter:     true,
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// backoff returns the wait before the given retry attempt (starting at 1).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.WaitMin
+	for i := 1; i < attempt && wait < p.WaitMax; i++ {
+		wait *= 2
+	}
+	if p.WaitMax > 0 && wait > p.WaitMax {
+		wait = p.WaitMax
+	}
+
+	if p.Jitter && wait > 0 {
+		half := wait / 2
+		wait = half + rand.N(wait-half+1) // #nosec G404 - jitter does not need a secure source
+	}
+
+	return wait
+}
+
+// shouldRetry determines whether a failed request may be sent again. Requests
+// with an idempotent method are retried on any retryable error. Other methods,
+// such as POST, are only retried when the server cannot have acted on the
+// request: when it was never sent, or when it was rejected by rate limiting.
+func shouldRetry(method string, err error) bool {
+	if !isRetryableError(err) {
+		return false
+	}
+	if isIdempotentMethod(method) {
+		return true
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+	return isRequestNotSent(err)
+}
+
+// isIdempotentMethod reports whether repeating a request with the given
+// method has the same effect as sending it once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRequestNotSent reports whether err happened while establishing the
+// connection, i.e. before any part of the request reached the server.
+func isRequestNotSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,179 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// fastRetryPolicy keeps retry tests quick while still exercising backoff.
+var fastRetryPolicy = client.RetryPolicy{
+	MaxRetries: 2,
+	WaitMin:    time.Millisecond,
+	WaitMax:    5 * time.Millisecond,
+	Jitter:     true,
+}
+
+// newStatusServer returns a server that answers every request with the given
+// status code, counting the attempts.
+func newStatusServer(t *testing.T, statusCode int, attempts *int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+		w.WriteHeader(statusCode)
+		if _, err := fmt.Fprintf(w, `{"error": "Error %d"}`, statusCode); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_RetryPolicy_MaxRetries(t *testing.T) {
+	attempts := 0
+	server := newStatusServer(t, http.StatusServiceUnavailable, &attempts)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(fastRetryPolicy))
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "request failed after 3 attempts")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClient_RetryPolicy_Disabled(t *testing.T) {
+	attempts := 0
+	server := newStatusServer(t, http.StatusServiceUnavailable, &attempts)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(client.RetryPolicy{}))
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := client.DefaultRetryPolicy()
+	assert.Equal(t, 3, policy.MaxRetries)
+	assert.True(t, policy.Jitter, "the provider documents jitter as enabled by default")
+}
+
+func TestClient_RetryPolicy_Deadline(t *testing.T) {
+	attempts := 0
+	server := newStatusServer(t, http.StatusServiceUnavailable, &attempts)
+
+	policy := client.RetryPolicy{
+		MaxRetries: 5,
+		WaitMin:    time.Second,
+		WaitMax:    time.Second,
+		Deadline:   100 * time.Millisecond,
+	}
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(policy))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = c.GetUser(context.Background(), "test-id")
+	elapsed := time.Since(start)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "retry deadline")
+	var apiErr *client.APIError
+	assert.True(t, errors.As(err, &apiErr), "the last API error should be preserved")
+	assert.Equal(t, 1, attempts, "should not start a retry that ends after the deadline")
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestClient_RetryPolicy_PostNotRetriedAfterSend(t *testing.T) {
+	for _, statusCode := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(statusCode), func(t *testing.T) {
+			attempts := 0
+			server := newStatusServer(t, statusCode, &attempts)
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(fastRetryPolicy))
+			require.NoError(t, err)
+
+			_, err = c.CreateUser(context.Background(), &client.UserCreateRequest{Username: "test", Email: "test@example.com"})
+			assert.Error(t, err)
+			assert.Equal(t, 1, attempts, "a POST that reached the server must not be retried")
+		})
+	}
+}
+
+func TestClient_RetryPolicy_PostRetriedWhenRateLimited(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			if _, err := fmt.Fprint(w, `{"error": "Rate limit exceeded"}`); err != nil {
+				t.Fatalf("Failed to write response: %v", err)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&client.User{ID: "test-id", Username: "test"}); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(fastRetryPolicy))
+	require.NoError(t, err)
+
+	user, err := c.CreateUser(context.Background(), &client.UserCreateRequest{Username: "test", Email: "test@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "test-id", user.ID)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_RetryPolicy_PostRetriedWhenNotSent(t *testing.T) {
+	// A closed server refuses connections, so the request is never sent.
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(fastRetryPolicy))
+	require.NoError(t, err)
+
+	_, err = c.CreateUser(context.Background(), &client.UserCreateRequest{Username: "test", Email: "test@example.com"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "request failed after 3 attempts")
+}
+
+func TestClient_RateLimitErrorUnwrap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		if _, err := fmt.Fprint(w, `{"error": "Rate limit exceeded"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(client.RetryPolicy{}))
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	require.Error(t, err)
+
+	var rateLimitErr *client.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, "1", rateLimitErr.RetryAfter)
+
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.Equal(t, "/api/users/test-id", apiErr.Endpoint)
+}
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestClient_CreateSignupToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/signup-tokens", r.URL.Path)
+
+		var req client.SignupTokenCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "72h", req.TTL)
+		assert.Equal(t, int64(5), req.UsageLimit)
+		assert.Equal(t, []string{"group-1"}, req.UserGroupIDs)
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprint(w, `{"id": "st-123", "token": "abc", "usageLimit": 5, "usageCount": 0, "expiresAt": "2030-01-01T00:00:00Z"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	token, err := c.CreateSignupToken(context.Background(), &client.SignupTokenCreateRequest{TTL: "72h", UsageLimit: 5, UserGroupIDs: []string{"group-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "st-123", token.ID)
+	assert.Equal(t, "abc", token.Token)
+}
+
+func TestClient_GetSignupToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/signup-tokens", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.PaginatedResponse[client.SignupToken]{
+			Data:       []client.SignupToken{{ID: "st-1", UsageCount: 1}, {ID: "st-2", UsageCount: 2}},
+			Pagination: client.PaginationInfo{TotalPages: 1, CurrentPage: 1},
+		}); err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	token, err := c.GetSignupToken(context.Background(), "st-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), token.UsageCount)
+
+	_, err = c.GetSignupToken(context.Background(), "st-3")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestClient_DeleteSignupToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/signup-tokens/st-123", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.DeleteSignupToken(context.Background(), "st-123"))
+}
+
+func TestClient_SignupURL(t *testing.T) {
+	c, err := client.NewClient("https://id.example.com/pocket-id/", "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://id.example.com/pocket-id/st/abc123", c.SignupURL("abc123"))
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// tlsOptions holds the PEM encoded certificates used to build the TLS
+// configuration of the client.
+type tlsOptions struct {
+	caCertPEM     []byte
+	clientCertPEM []byte
+	clientKeyPEM  []byte
+}
+
+// WithCACertPEM makes the client trust the PEM encoded CA certificates in
+// addition to the system certificate pool.
+func WithCACertPEM(caCertPEM []byte) Option {
+	return func(c *Client) {
+		c.tls.caCertPEM = caCertPEM
+	}
+}
+
+// WithClientCertificatePEM makes the client present the PEM encoded
+// certificate and private key when the server requests a client certificate.
+func WithClientCertificatePEM(certPEM, keyPEM []byte) Option {
+	return func(c *Client) {
+		c.tls.clientCertPEM = certPEM
+		c.tls.clientKeyPEM = keyPEM
+	}
+}
+
+// apply adds the configured CA certificates and client certificate to config.
+func (o tlsOptions) apply(config *tls.Config) error {
+	if len(o.caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(o.caCertPEM) {
+			return fmt.Errorf("no valid certificate found in CA certificate PEM")
+		}
+		config.RootCAs = pool
+	}
+
+	if len(o.clientCertPEM) > 0 || len(o.clientKeyPEM) > 0 {
+		if len(o.clientCertPEM) == 0 || len(o.clientKeyPEM) == 0 {
+			return fmt.Errorf("client certificate and client key must be set together")
+		}
+		cert, err := tls.X509KeyPair(o.clientCertPEM, o.clientKeyPEM)
+		if err != nil {
+			return fmt.Errorf("error loading client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// newTLSUserServer starts a TLS server that answers every request with a user.
+func newTLSUserServer(t *testing.T, clientCAs *x509.CertPool) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&client.User{ID: "test-id"}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	if clientCAs != nil {
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// serverCertPEM returns the PEM encoded certificate of a TLS test server.
+func serverCertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// newClientCertificate generates a self-signed client certificate and returns
+// it PEM encoded together with its private key.
+func newClientCertificate(t *testing.T) (certPEM, keyPEM []byte, cert *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, cert
+}
+
+func TestClient_CACertPEM(t *testing.T) {
+	server := newTLSUserServer(t, nil)
+	noRetry := client.WithRetryPolicy(client.RetryPolicy{})
+
+	untrusted, err := client.NewClient(server.URL, "test-token", false, 30, noRetry)
+	require.NoError(t, err)
+	_, err = untrusted.GetUser(context.Background(), "test-id")
+	assert.Error(t, err, "the test server certificate should not be trusted by default")
+
+	trusted, err := client.NewClient(server.URL, "test-token", false, 30, noRetry, client.WithCACertPEM(serverCertPEM(server)))
+	require.NoError(t, err)
+	user, err := trusted.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "test-id", user.ID)
+}
+
+func TestClient_CACertPEM_Invalid(t *testing.T) {
+	_, err := client.NewClient("https://pocketid.example.com", "test-token", false, 30, client.WithCACertPEM([]byte("not a certificate")))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "CA certificate")
+}
+
+func TestClient_ClientCertificatePEM(t *testing.T) {
+	certPEM, keyPEM, cert := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+	server := newTLSUserServer(t, clientCAs)
+	noRetry := client.WithRetryPolicy(client.RetryPolicy{})
+	trustServer := client.WithCACertPEM(serverCertPEM(server))
+
+	withoutCert, err := client.NewClient(server.URL, "test-token", false, 30, noRetry, trustServer)
+	require.NoError(t, err)
+	_, err = withoutCert.GetUser(context.Background(), "test-id")
+	assert.Error(t, err, "the server should require a client certificate")
+
+	withCert, err := client.NewClient(server.URL, "test-token", false, 30, noRetry, trustServer,
+		client.WithClientCertificatePEM(certPEM, keyPEM))
+	require.NoError(t, err)
+	user, err := withCert.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "test-id", user.ID)
+}
+
+func TestClient_ClientCertificatePEM_Invalid(t *testing.T) {
+	certPEM, _, _ := newClientCertificate(t)
+
+	_, err := client.NewClient("https://pocketid.example.com", "test-token", false, 30, client.WithClientCertificatePEM(certPEM, nil))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be set together")
+
+	_, err = client.NewClient("https://pocketid.example.com", "test-token", false, 30, client.WithClientCertificatePEM(certPEM, []byte("not a key")))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error loading client certificate")
+}
@@ -0,0 +1,103 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// newRecordingServer answers every request with a user and stores the last
+// request received.
+func newRecordingServer(t *testing.T, last **http.Request) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*last = r
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&client.User{ID: "test-id"}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_BaseURLPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		path         string
+		expectedPath string
+	}{
+		{name: "no path", path: "", expectedPath: "/api/users/test-id"},
+		{name: "trailing slash", path: "/", expectedPath: "/api/users/test-id"},
+		{name: "sub-path", path: "/pocket-id", expectedPath: "/pocket-id/api/users/test-id"},
+		{name: "sub-path with trailing slash", path: "/pocket-id/", expectedPath: "/pocket-id/api/users/test-id"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var last *http.Request
+			server := newRecordingServer(t, &last)
+
+			c, err := client.NewClient(server.URL+tc.path, "test-token", false, 30)
+			require.NoError(t, err)
+
+			_, err = c.GetUser(context.Background(), "test-id")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPath, last.URL.Path)
+		})
+	}
+}
+
+func TestClient_BaseURLPath_Query(t *testing.T) {
+	var last *http.Request
+	server := newRecordingServer(t, &last)
+
+	c, err := client.NewClient(server.URL+"/pocket-id/", "test-token", false, 30, client.WithPageSize(10))
+	require.NoError(t, err)
+
+	_, err = c.ListUsersPage(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "/pocket-id/api/users", last.URL.Path)
+	assert.Equal(t, "2", last.URL.Query().Get("pagination[page]"))
+}
+
+func TestClient_WithHeaders(t *testing.T) {
+	var last *http.Request
+	server := newRecordingServer(t, &last)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithHeaders(map[string]string{
+		"CF-Access-Client-Id": "client-id",
+		"X-API-KEY":           "other-token",
+	}))
+	require.NoError(t, err)
+
+	_, err = c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "client-id", last.Header.Get("CF-Access-Client-Id"))
+	assert.Equal(t, "test-token", last.Header.Get("X-API-KEY"), "custom headers must not replace the API token")
+}
+
+func TestClient_WithProxyURL(t *testing.T) {
+	var last *http.Request
+	proxy := newRecordingServer(t, &last)
+
+	c, err := client.NewClient("http://pocketid.invalid", "test-token", false, 30, client.WithProxyURL(proxy.URL))
+	require.NoError(t, err)
+
+	user, err := c.GetUser(context.Background(), "test-id")
+	require.NoError(t, err)
+	assert.Equal(t, "test-id", user.ID)
+	assert.Equal(t, "pocketid.invalid", last.Host, "the request should be sent through the proxy")
+}
+
+func TestClient_WithProxyURL_Invalid(t *testing.T) {
+	_, err := client.NewClient("https://pocketid.example.com", "test-token", false, 30, client.WithProxyURL("not a url"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid proxy URL")
+}
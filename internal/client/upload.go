@@ -0,0 +1,37 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+)
+
+// rawBody is a request body that is sent as-is with its own content type,
+// such as a binary image or a multipart form, instead of being encoded as
+// JSON. The content is kept in memory and read through a new reader on every
+// attempt, so a retried request sends the complete body again.
+type rawBody struct {
+	contentType string
+	content     []byte
+}
+
+// newMultipartBody returns a multipart/form-data request body holding a single
+// file in the given form field. Pocket-ID derives the image type from the
+// extension of the file name, so it must be set.
+func newMultipartBody(field, fileName string, content []byte) (*rawBody, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	part, err := w.CreateFormFile(field, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("error creating multipart body: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("error writing multipart body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("error closing multipart body: %w", err)
+	}
+
+	return &rawBody{contentType: w.FormDataContentType(), content: buf.Bytes()}, nil
+}
@@ -0,0 +1,203 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// readUploadedFile returns the name and content of the file in the "file"
+// field of a multipart request.
+func readUploadedFile(t *testing.T, r *http.Request) (string, []byte) {
+	t.Helper()
+
+	file, header, err := r.FormFile("file")
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	return header.Filename, content
+}
+
+func TestClient_UploadClientLogo(t *testing.T) {
+	tests := []struct {
+		name  string
+		light bool
+	}{
+		{name: "light", light: true},
+		{name: "dark", light: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/api/oidc/clients/client-123/logo", r.URL.Path)
+				assert.Equal(t, fmt.Sprint(tt.light), r.URL.Query().Get("light"))
+				assert.Equal(t, "test-token", r.Header.Get("X-API-KEY"))
+
+				fileName, content := readUploadedFile(t, r)
+				assert.Equal(t, "logo.png", fileName)
+				assert.Equal(t, []byte("png-data"), content)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			assert.NoError(t, c.UploadClientLogo(context.Background(), "client-123", tt.light, "logo.png", []byte("png-data")))
+		})
+	}
+}
+
+func TestClient_UploadClientLogo_RetryReplaysBody(t *testing.T) {
+	var uploads [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, content := readUploadedFile(t, r)
+		uploads = append(uploads, content)
+		if len(uploads) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithRetryPolicy(fastRetryPolicy))
+	require.NoError(t, err)
+
+	require.NoError(t, c.UploadClientLogo(context.Background(), "client-123", true, "logo.svg", []byte("<svg></svg>")))
+	require.Len(t, uploads, 2)
+	for _, content := range uploads {
+		assert.Equal(t, []byte("<svg></svg>"), content, "every attempt must send the complete body")
+	}
+}
+
+func TestClient_GetClientLogo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/oidc/clients/client-123/logo", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("light"))
+
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("png-data"))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	content, err := c.GetClientLogo(context.Background(), "client-123", false)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png-data"), content)
+}
+
+func TestClient_DeleteClientLogo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/oidc/clients/client-123/logo", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("light"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.DeleteClientLogo(context.Background(), "client-123", true))
+}
+
+func TestClient_UploadApplicationImage(t *testing.T) {
+	tests := []struct {
+		image     client.ApplicationImage
+		wantPath  string
+		wantLight string
+	}{
+		{image: client.ApplicationImageLogo, wantPath: "/api/application-images/logo", wantLight: "true"},
+		{image: client.ApplicationImageDarkLogo, wantPath: "/api/application-images/logo", wantLight: "false"},
+		{image: client.ApplicationImageFavicon, wantPath: "/api/application-images/favicon"},
+		{image: client.ApplicationImageBackground, wantPath: "/api/application-images/background"},
+		{image: client.ApplicationImageEmailLogo, wantPath: "/api/application-images/email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.image), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PUT", r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				assert.Equal(t, tt.wantLight, r.URL.Query().Get("light"))
+
+				fileName, content := readUploadedFile(t, r)
+				assert.Equal(t, "image.png", fileName)
+				assert.Equal(t, []byte("png-data"), content)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			assert.NoError(t, c.UploadApplicationImage(context.Background(), tt.image, "image.png", []byte("png-data")))
+		})
+	}
+}
+
+func TestClient_ResetApplicationImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/application-images/background", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.ResetApplicationImage(context.Background(), client.ApplicationImageBackground))
+}
+
+func TestClient_UserProfilePicture(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "PUT":
+			fileName, content := readUploadedFile(t, r)
+			assert.Equal(t, "avatar.jpg", fileName)
+			assert.Equal(t, []byte("jpeg-data"), content)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("png-data"))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, c.UploadUserProfilePicture(ctx, "user-123", "avatar.jpg", []byte("jpeg-data")))
+	picture, err := c.GetUserProfilePicture(ctx, "user-123")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png-data"), picture)
+	require.NoError(t, c.ResetUserProfilePicture(ctx, "user-123"))
+
+	assert.Equal(t, []string{
+		"PUT /api/users/user-123/profile-picture",
+		"GET /api/users/user-123/profile-picture.png",
+		"DELETE /api/users/user-123/profile-picture",
+	}, requests)
+}
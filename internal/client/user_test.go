@@ -1,11 +1,18 @@
 package client_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,7 +50,7 @@ func TestClient_GetUser(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUser("test-user-id")
+	result, err := c.GetUser(context.Background(), "test-user-id")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, result)
 }
@@ -60,7 +67,7 @@ func TestClient_GetUser_Error(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUser("nonexistent-id")
+	result, err := c.GetUser(context.Background(), "nonexistent-id")
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "HTTP 404")
@@ -107,7 +114,7 @@ func TestClient_UpdateUser(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.UpdateUser("test-user-id", updateReq)
+	result, err := c.UpdateUser(context.Background(), "test-user-id", updateReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, result)
 }
@@ -124,7 +131,7 @@ func TestClient_DeleteUser(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteUser("test-user-id")
+	err = c.DeleteUser(context.Background(), "test-user-id")
 	assert.NoError(t, err)
 }
 
@@ -140,7 +147,7 @@ func TestClient_DeleteUser_Error(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteUser("test-user-id")
+	err = c.DeleteUser(context.Background(), "test-user-id")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 403")
 }
@@ -192,7 +199,7 @@ func TestClient_ListUsers(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUsers()
+	result, err := c.ListUsers(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResponse, result)
 	assert.Equal(t, expectedUsers, result.Data)
@@ -223,7 +230,7 @@ func TestClient_ListUsers_Empty(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUsers()
+	result, err := c.ListUsers(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResponse, result)
 	assert.Empty(t, result.Data)
@@ -250,7 +257,7 @@ func TestClient_GetUserGroup(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUserGroup("test-group-id")
+	result, err := c.GetUserGroup(context.Background(), "test-group-id")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedGroup, result)
 }
@@ -267,7 +274,7 @@ func TestClient_GetUserGroup_NotFound(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.GetUserGroup("nonexistent-id")
+	result, err := c.GetUserGroup(context.Background(), "nonexistent-id")
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "HTTP 404")
@@ -304,11 +311,50 @@ func TestClient_UpdateUserGroup(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.UpdateUserGroup("test-group-id", updateReq)
+	result, err := c.UpdateUserGroup(context.Background(), "test-group-id", updateReq)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedGroup, result)
 }
 
+func TestClient_UpdateUserGroupUsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		userIDs []string
+		want    string
+	}{
+		{name: "members", userIDs: []string{"user-1", "user-2"}, want: `{"userIds":["user-1","user-2"]}`},
+		{name: "no members", userIDs: nil, want: `{"userIds":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "GET" {
+					// The current members are read to lock their memberships.
+					assert.Equal(t, "/api/user-groups/test-group-id", r.URL.Path)
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"id":"test-group-id","users":[{"id":"user-1"}]}`))
+					return
+				}
+				assert.Equal(t, "PUT", r.Method)
+				assert.Equal(t, "/api/user-groups/test-group-id/users", r.URL.Path)
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, tt.want, string(body))
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(server.URL, "test-token", false, 30)
+			require.NoError(t, err)
+
+			assert.NoError(t, c.UpdateUserGroupUsers(context.Background(), "test-group-id", tt.userIDs))
+		})
+	}
+}
+
 func TestClient_DeleteUserGroup(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "DELETE", r.Method)
@@ -321,7 +367,7 @@ func TestClient_DeleteUserGroup(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteUserGroup("test-group-id")
+	err = c.DeleteUserGroup(context.Background(), "test-group-id")
 	assert.NoError(t, err)
 }
 
@@ -337,7 +383,7 @@ func TestClient_DeleteUserGroup_InUse(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	err = c.DeleteUserGroup("test-group-id")
+	err = c.DeleteUserGroup(context.Background(), "test-group-id")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP 409")
 }
@@ -385,7 +431,7 @@ func TestClient_ListUserGroups(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUserGroups()
+	result, err := c.ListUserGroups(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResponse, result)
 	assert.Equal(t, expectedGroups, result.Data)
@@ -403,12 +449,245 @@ func TestClient_ListUserGroups_Error(t *testing.T) {
 	c, err := client.NewClient(server.URL, "test-token", false, 30)
 	require.NoError(t, err)
 
-	result, err := c.ListUserGroups()
+	result, err := c.ListUserGroups(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "HTTP 500")
 }
 
+// newUserGroupsServer serves GET /api/users/{id} and PUT
+// /api/users/{id}/user-groups for a single user whose groups are kept in
+// memory, and the group side of the same memberships through GET
+// /api/user-groups/{id} and PUT /api/user-groups/{id}/users. It returns the
+// server and a function reporting the user's groups.
+func newUserGroupsServer(t *testing.T, initial ...string) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	groupIDs := append([]string{}, initial...)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/users/user-123":
+			user := client.User{ID: "user-123"}
+			for _, id := range groupIDs {
+				user.UserGroups = append(user.UserGroups, client.UserGroup{ID: id})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(user)
+		case r.Method == "PUT" && r.URL.Path == "/api/users/user-123/user-groups":
+			var req client.UpdateUserGroupsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			groupIDs = req.UserGroupIDs
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api/user-groups/"):
+			group := client.UserGroup{ID: strings.TrimPrefix(r.URL.Path, "/api/user-groups/")}
+			if slices.Contains(groupIDs, group.ID) {
+				group.Users = []client.User{{ID: "user-123"}}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(group)
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/api/user-groups/") && strings.HasSuffix(r.URL.Path, "/users"):
+			var req client.UpdateUserGroupUsersRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			groupID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/user-groups/"), "/users")
+			groupIDs = slices.DeleteFunc(groupIDs, func(id string) bool { return id == groupID })
+			if slices.Contains(req.UserIDs, "user-123") {
+				groupIDs = append(groupIDs, groupID)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, groupIDs...)
+	}
+}
+
+func TestClient_AddUserToGroup(t *testing.T) {
+	server, groups := newUserGroupsServer(t, "group-a")
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, c.AddUserToGroup(ctx, "user-123", "group-b"))
+	assert.Equal(t, []string{"group-a", "group-b"}, groups())
+
+	// Adding an existing membership leaves the groups unchanged.
+	require.NoError(t, c.AddUserToGroup(ctx, "user-123", "group-a"))
+	assert.Equal(t, []string{"group-a", "group-b"}, groups())
+}
+
+func TestClient_RemoveUserFromGroup(t *testing.T) {
+	server, groups := newUserGroupsServer(t, "group-a", "group-b")
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, c.RemoveUserFromGroup(ctx, "user-123", "group-a"))
+	assert.Equal(t, []string{"group-b"}, groups())
+
+	require.NoError(t, c.RemoveUserFromGroup(ctx, "user-123", "group-missing"))
+	assert.Equal(t, []string{"group-b"}, groups())
+}
+
+// Memberships added concurrently for the same user must not overwrite each
+// other.
+func TestClient_AddUserToGroup_Concurrent(t *testing.T) {
+	server, groups := newUserGroupsServer(t)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.AddUserToGroup(context.Background(), "user-123", fmt.Sprintf("group-%d", i)))
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, groups(), 10)
+}
+
+// Full replacements of a user's memberships, from either side, must not
+// overwrite memberships added concurrently for the same user.
+func TestClient_UpdateUserGroups_ConcurrentWithAddUserToGroup(t *testing.T) {
+	server, groups := newUserGroupsServer(t, "group-a")
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30, client.WithResponseCache())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.AddUserToGroup(ctx, "user-123", fmt.Sprintf("group-%d", i)))
+		}()
+	}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, c.UpdateUserGroupUsers(ctx, "group-a", nil))
+	}()
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, c.UpdateUserGroupUsers(ctx, "group-b", []string{"user-123"}))
+	}()
+	wg.Wait()
+
+	got := groups()
+	assert.Len(t, got, 11)
+	assert.NotContains(t, got, "group-a")
+	assert.Contains(t, got, "group-b")
+}
+
+func TestClient_AddUserToGroup_CancelledWhileWaiting(t *testing.T) {
+	release := make(chan struct{})
+	var puts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"user-123"}`))
+		case "PUT":
+			puts.Add(1)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- c.AddUserToGroup(context.Background(), "user-123", "group-a")
+	}()
+	require.Eventually(t, func() bool { return puts.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// The first update holds the user's lock, so the second one waits for it
+	// and gives up when its context is cancelled.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = c.AddUserToGroup(ctx, "user-123", "group-b")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), puts.Load())
+
+	close(release)
+	require.NoError(t, <-done)
+}
+
+func TestClient_AddUserToGroup_UserNotFound(t *testing.T) {
+	server, _ := newUserGroupsServer(t)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	err = c.AddUserToGroup(context.Background(), "user-missing", "group-a")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestClient_ListUserWebauthnCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/users/user-123/webauthn-credentials", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{
+			"id": "cred-1",
+			"name": "YubiKey",
+			"credentialID": "Y3JlZC0x",
+			"aaguid": "ee882879-721c-4913-9775-3dfcce97072a",
+			"backupEligible": false,
+			"backupState": false,
+			"createdAt": "2026-01-02T03:04:05Z",
+			"lastUsedAt": "2026-02-03T04:05:06Z"
+		}]`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	credentials, err := c.ListUserWebauthnCredentials(context.Background(), "user-123")
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.Equal(t, "cred-1", credentials[0].ID)
+	assert.Equal(t, "YubiKey", credentials[0].Name)
+	assert.Equal(t, "ee882879-721c-4913-9775-3dfcce97072a", credentials[0].AAGUID)
+	assert.Equal(t, "2026-02-03T04:05:06Z", credentials[0].LastUsedAt)
+}
+
+func TestClient_DeleteUserWebauthnCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/users/user-123/webauthn-credentials/cred-1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.DeleteUserWebauthnCredential(context.Background(), "user-123", "cred-1"))
+}
+
 // Helper function to create a string pointer
 func stringPtr(s string) *string {
 	return &s
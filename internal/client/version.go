@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Version is a Pocket-ID server version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses a version such as "v2.9.0" or "2.9.0-beta.1". Any
+// pre-release or build suffix is ignored.
+func ParseVersion(s string) (Version, error) {
+	core := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		numbers[i] = n
+	}
+
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// String returns the version in the "v1.2.3" form used by Pocket-ID releases.
+func (v Version) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast reports whether v is the same as or newer than other.
+func (v Version) AtLeast(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// Capability is a server feature that is only available from a given
+// Pocket-ID version.
+type Capability string
+
+const (
+	// CapabilityPushedAuthorizationRequests is support for requiring Pushed
+	// Authorization Requests (PAR) on OIDC clients.
+	CapabilityPushedAuthorizationRequests Capability = "pushed_authorization_requests"
+)
+
+// capabilityVersions maps each capability to the first version providing it.
+var capabilityVersions = map[Capability]Version{
+	CapabilityPushedAuthorizationRequests: {Major: 2, Minor: 9, Patch: 0},
+}
+
+// MinimumVersion returns the first Pocket-ID version providing the capability.
+func (c Capability) MinimumVersion() Version {
+	return capabilityVersions[c]
+}
+
+// versionDetectionTimeout bounds the version request independently of the
+// client timeout, as the version is optional and detected on every run.
+const versionDetectionTimeout = 3 * time.Second
+
+// DetectServerVersion retrieves the version of the connected Pocket-ID server
+// and stores it on the client for capability checks. The request is sent only
+// once, without retries, and gives up after versionDetectionTimeout, so an
+// unreachable server delays the caller by a few seconds at most.
+func (c *Client) DetectServerVersion(ctx context.Context) (Version, error) {
+	ctx, cancel := context.WithTimeout(ctx, versionDetectionTimeout)
+	defer cancel()
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	body, err := c.doSingleRequest(ctx, "GET", "/api/version/current", nil)
+	release()
+	if err != nil {
+		return Version{}, err
+	}
+
+	var result struct {
+		CurrentVersion string `json:"currentVersion"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return Version{}, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	version, err := ParseVersion(result.CurrentVersion)
+	if err != nil {
+		return Version{}, err
+	}
+
+	c.serverVersion = &version
+	return version, nil
+}
+
+// ServerVersion returns the version detected by DetectServerVersion. The
+// boolean is false when the version is unknown.
+func (c *Client) ServerVersion() (Version, bool) {
+	if c.serverVersion == nil {
+		return Version{}, false
+	}
+	return *c.serverVersion, true
+}
+
+// Supports reports whether the connected server provides the capability. The
+// second return value is false when the server version is unknown, in which
+// case the capability is assumed to be supported.
+func (c *Client) Supports(capability Capability) (supported bool, known bool) {
+	version, ok := c.ServerVersion()
+	if !ok {
+		return true, false
+	}
+	return version.AtLeast(capability.MinimumVersion()), true
+}
@@ -0,0 +1,117 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected client.Version
+		wantErr  bool
+	}{
+		{input: "v2.9.0", expected: client.Version{Major: 2, Minor: 9, Patch: 0}},
+		{input: "1.15.3", expected: client.Version{Major: 1, Minor: 15, Patch: 3}},
+		{input: "v2.10.0-beta.1", expected: client.Version{Major: 2, Minor: 10, Patch: 0}},
+		{input: "2.0.0+build", expected: client.Version{Major: 2, Minor: 0, Patch: 0}},
+		{input: "", wantErr: true},
+		{input: "v2.9", wantErr: true},
+		{input: "latest", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			version, err := client.ParseVersion(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestVersion_AtLeast(t *testing.T) {
+	v := client.Version{Major: 2, Minor: 9, Patch: 0}
+
+	assert.True(t, v.AtLeast(client.Version{Major: 2, Minor: 9, Patch: 0}))
+	assert.True(t, v.AtLeast(client.Version{Major: 2, Minor: 8, Patch: 5}))
+	assert.True(t, v.AtLeast(client.Version{Major: 1, Minor: 20, Patch: 0}))
+	assert.False(t, v.AtLeast(client.Version{Major: 2, Minor: 9, Patch: 1}))
+	assert.False(t, v.AtLeast(client.Version{Major: 3, Minor: 0, Patch: 0}))
+	assert.Equal(t, "v2.9.0", v.String())
+}
+
+func TestClient_DetectServerVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/version/current", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprint(w, `{"currentVersion": "2.8.0"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	supported, known := c.Supports(client.CapabilityPushedAuthorizationRequests)
+	assert.True(t, supported, "capabilities are assumed supported before detection")
+	assert.False(t, known)
+
+	version, err := c.DetectServerVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, client.Version{Major: 2, Minor: 8, Patch: 0}, version)
+
+	stored, ok := c.ServerVersion()
+	assert.True(t, ok)
+	assert.Equal(t, version, stored)
+
+	supported, known = c.Supports(client.CapabilityPushedAuthorizationRequests)
+	assert.False(t, supported)
+	assert.True(t, known)
+}
+
+func TestClient_DetectServerVersion_NotRetried(t *testing.T) {
+	attempts := 0
+	server := newStatusServer(t, http.StatusServiceUnavailable, &attempts)
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	_, err = c.DetectServerVersion(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	_, ok := c.ServerVersion()
+	assert.False(t, ok)
+}
+
+func TestClient_DetectServerVersion_ShortTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(10 * time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL, "test-token", false, 30)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = c.DetectServerVersion(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second, "version detection must not wait for the full client timeout")
+}
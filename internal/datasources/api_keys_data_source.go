@@ -0,0 +1,187 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiKeysDataSource{}
+)
+
+// NewAPIKeysDataSource creates a new API keys data source.
+func NewAPIKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+// apiKeysDataSource is the data source implementation.
+type apiKeysDataSource struct {
+	client *client.Client
+}
+
+// apiKeysDataSourceModel describes the data source data model.
+type apiKeysDataSourceModel struct {
+	APIKeys []apiKeyModel `tfsdk:"api_keys"`
+}
+
+// apiKeyModel describes the API key data model.
+type apiKeyModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	LastUsedAt          types.String `tfsdk:"last_used_at"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+	ExpirationEmailSent types.Bool   `tfsdk:"expiration_email_sent"`
+	ExpiresInDays       types.Int64  `tfsdk:"expires_in_days"`
+}
+
+// Metadata returns the data source type name.
+func (d *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about all Pocket-ID API keys. The keys themselves are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"api_keys": schema.ListNestedAttribute{
+				Description: "List of all API keys.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the API key.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the API key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the API key. Null when not set.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation time of the API key in RFC3339 format.",
+							Computed:    true,
+						},
+						"last_used_at": schema.StringAttribute{
+							Description: "The time the API key was last used in RFC3339 format. Null if it has never been used.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "The expiration time of the API key in RFC3339 format.",
+							Computed:    true,
+						},
+						"expiration_email_sent": schema.BoolAttribute{
+							Description: "Whether Pocket-ID has sent the email warning that the API key is about to expire.",
+							Computed:    true,
+						},
+						"expires_in_days": schema.Int64Attribute{
+							Description: "Number of whole days until the API key expires, as of the time the data source is read. " +
+								"Zero or negative once the key has expired.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all API keys
+	apiKeysResp, err := d.client.ListAPIKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read API Keys",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Retrieved API keys", map[string]interface{}{
+		"count": len(apiKeysResp.Data),
+	})
+
+	// Map response body to model
+	now := time.Now()
+	data.APIKeys = make([]apiKeyModel, len(apiKeysResp.Data))
+	for i, apiKey := range apiKeysResp.Data {
+		data.APIKeys[i] = apiKeyModel{
+			ID:                  types.StringValue(apiKey.ID),
+			Name:                types.StringValue(apiKey.Name),
+			Description:         optionalStringValue(apiKey.Description),
+			CreatedAt:           optionalStringValue(apiKey.CreatedAt),
+			LastUsedAt:          optionalStringValue(apiKey.LastUsedAt),
+			ExpiresAt:           optionalStringValue(apiKey.ExpiresAt),
+			ExpirationEmailSent: types.BoolValue(apiKey.ExpirationEmailSent),
+			ExpiresInDays:       daysUntil(apiKey.ExpiresAt, now),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalStringValue returns a null string for an empty API value.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// daysUntil returns the number of whole days from now until the RFC3339
+// timestamp, rounded down so a key expiring in 36 hours reports 1 day. It is
+// null when the timestamp cannot be parsed.
+func daysUntil(timestamp string, now time.Time) types.Int64 {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(math.Floor(t.Sub(now).Hours() / 24)))
+}
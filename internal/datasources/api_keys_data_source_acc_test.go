@@ -0,0 +1,63 @@
+//go:build acc
+// +build acc
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKeysDataSource_ReadAll(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	expiresAt := time.Now().Add(10*24*time.Hour + time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeysDataSourceConfig(rName, expiresAt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("expires_in_days", "10"),
+					resource.TestCheckOutput("description", "Managed by Terraform"),
+					resource.TestCheckOutput("never_used", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeysDataSourceConfig(rName, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "pocketid_api_key" "test" {
+  name        = %[1]q
+  description = "Managed by Terraform"
+  expires_at  = %[2]q
+}
+
+data "pocketid_api_keys" "all" {
+  depends_on = [pocketid_api_key.test]
+}
+
+locals {
+  test_key = one([for k in data.pocketid_api_keys.all.api_keys : k if k.name == %[1]q])
+}
+
+output "expires_in_days" {
+  value = tostring(local.test_key.expires_in_days)
+}
+
+output "description" {
+  value = local.test_key.description
+}
+
+output "never_used" {
+  value = tostring(local.test_key.last_used_at == null)
+}
+`, rName, expiresAt)
+}
@@ -0,0 +1,32 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		timestamp string
+		expected  types.Int64
+	}{
+		{name: "in 30 days", timestamp: "2026-01-31T12:00:00Z", expected: types.Int64Value(30)},
+		{name: "rounded down", timestamp: "2026-01-03T00:00:00Z", expected: types.Int64Value(1)},
+		{name: "less than a day", timestamp: "2026-01-01T18:00:00Z", expected: types.Int64Value(0)},
+		{name: "expired", timestamp: "2025-12-31T00:00:00Z", expected: types.Int64Value(-2)},
+		{name: "other time zone", timestamp: "2026-01-11T13:00:00+01:00", expected: types.Int64Value(10)},
+		{name: "invalid", timestamp: "", expected: types.Int64Null()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, daysUntil(tc.timestamp, now))
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// defaultAuditLogMaxResults caps the number of audit log entries read when
+// max_results is not set, as the audit log of a busy instance grows quickly.
+const defaultAuditLogMaxResults = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &auditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogsDataSource{}
+)
+
+// NewAuditLogsDataSource creates a new audit logs data source.
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+// auditLogsDataSource is the data source implementation.
+type auditLogsDataSource struct {
+	client *client.Client
+}
+
+// auditLogsDataSourceModel describes the data source data model.
+type auditLogsDataSourceModel struct {
+	UserID     types.String    `tfsdk:"user_id"`
+	Event      types.String    `tfsdk:"event"`
+	ClientName types.String    `tfsdk:"client_name"`
+	Since      types.String    `tfsdk:"since"`
+	Until      types.String    `tfsdk:"until"`
+	MaxResults types.Int64     `tfsdk:"max_results"`
+	AuditLogs  []auditLogModel `tfsdk:"audit_logs"`
+}
+
+// auditLogModel describes the audit log entry data model.
+type auditLogModel struct {
+	ID         types.String `tfsdk:"id"`
+	Event      types.String `tfsdk:"event"`
+	UserID     types.String `tfsdk:"user_id"`
+	Username   types.String `tfsdk:"username"`
+	IPAddress  types.String `tfsdk:"ip_address"`
+	UserAgent  types.String `tfsdk:"user_agent"`
+	Country    types.String `tfsdk:"country"`
+	City       types.String `tfsdk:"city"`
+	ClientName types.String `tfsdk:"client_name"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *auditLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves entries of the Pocket-ID audit log, newest first, optionally filtered by user, event, client and time range.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "Only return entries of the user with this ID.",
+				Optional:    true,
+			},
+			"event": schema.StringAttribute{
+				Description: "Only return entries of this event type, e.g. SIGN_IN, TOKEN_SIGN_IN, CLIENT_AUTHORIZATION or NEW_CLIENT_AUTHORIZATION.",
+				Optional:    true,
+			},
+			"client_name": schema.StringAttribute{
+				Description: "Only return entries of the OIDC client with this name.",
+				Optional:    true,
+			},
+			"since": schema.StringAttribute{
+				Description: "Only return entries created at or after this time, in RFC3339 format.",
+				Optional:    true,
+			},
+			"until": schema.StringAttribute{
+				Description: "Only return entries created before this time, in RFC3339 format. Pocket-ID cannot filter by time, so newer entries are still read and discarded by the provider; a time range far in the past reads every newer entry first, so combine it with user_id, event or client_name on a busy instance.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of entries to return. Defaults to %d.", defaultAuditLogMaxResults),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				Description: "List of the matching audit log entries, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the entry.",
+							Computed:    true,
+						},
+						"event": schema.StringAttribute{
+							Description: "The event type.",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user the event belongs to.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user the event belongs to. Null when not reported by the server.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "The IP address the event originated from.",
+							Computed:    true,
+						},
+						"user_agent": schema.StringAttribute{
+							Description: "The browser and operating system, as derived by Pocket-ID from the user agent. Null when not reported by the server.",
+							Computed:    true,
+						},
+						"country": schema.StringAttribute{
+							Description: "The country the IP address is located in. Null when not reported by the server.",
+							Computed:    true,
+						},
+						"city": schema.StringAttribute{
+							Description: "The city the IP address is located in. Null when not reported by the server.",
+							Computed:    true,
+						},
+						"client_name": schema.StringAttribute{
+							Description: "The name of the OIDC client involved in the event. Null for events without a client.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the event occurred, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *auditLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data auditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := parseTimeAttribute(data.Since, path.Root("since"), resp)
+	until := parseTimeAttribute(data.Until, path.Root("until"), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := defaultAuditLogMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt64())
+	}
+
+	filter := client.AuditLogFilter{
+		UserID:     data.UserID.ValueString(),
+		Event:      data.Event.ValueString(),
+		ClientName: data.ClientName.ValueString(),
+	}
+
+	// Entries are listed newest first, so reading stops at the first entry
+	// older than since, or once enough entries have been collected.
+	data.AuditLogs = []auditLogModel{}
+	for entry, err := range d.client.IterAuditLogs(ctx, filter) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Audit Logs",
+				err.Error(),
+			)
+			return
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, entry.CreatedAt)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Audit Logs",
+				fmt.Sprintf("Audit log entry %s has an invalid creation time: %s", entry.ID, err.Error()),
+			)
+			return
+		}
+		if !since.IsZero() && createdAt.Before(since) {
+			break
+		}
+		if !until.IsZero() && !createdAt.Before(until) {
+			continue
+		}
+
+		data.AuditLogs = append(data.AuditLogs, auditLogModel{
+			ID:         types.StringValue(entry.ID),
+			Event:      types.StringValue(entry.Event),
+			UserID:     types.StringValue(entry.UserID),
+			Username:   optionalStringValue(entry.Username),
+			IPAddress:  types.StringValue(entry.IPAddress),
+			UserAgent:  optionalStringValue(entry.Device),
+			Country:    optionalStringValue(entry.Country),
+			City:       optionalStringValue(entry.City),
+			ClientName: optionalStringValue(entry.Data["clientName"]),
+			CreatedAt:  types.StringValue(entry.CreatedAt),
+		})
+		if len(data.AuditLogs) >= maxResults {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Retrieved audit logs", map[string]interface{}{
+		"count": len(data.AuditLogs),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseTimeAttribute parses the RFC3339 time of an optional attribute and
+// returns the zero time when it is null.
+func parseTimeAttribute(value types.String, p path.Path, resp *datasource.ReadResponse) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Invalid Time",
+			fmt.Sprintf("Expected a time in RFC3339 format, e.g. 2026-01-02T15:04:05Z, got %q: %s", value.ValueString(), err.Error()),
+		)
+	}
+	return t
+}
@@ -0,0 +1,51 @@
+//go:build acc
+// +build acc
+
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditLogsDataSource_filters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A user provisioned by Terraform has not signed in yet, so it has
+			// no audit log entries.
+			{
+				Config: fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+data "pocketid_audit_logs" "user" {
+  user_id = pocketid_user.test.id
+}
+
+data "pocketid_audit_logs" "latest" {
+  max_results = 1
+}
+
+data "pocketid_audit_logs" "future" {
+  since = "2999-01-01T00:00:00Z"
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pocketid_audit_logs.user", "audit_logs.#", "0"),
+					resource.TestCheckResourceAttr("data.pocketid_audit_logs.future", "audit_logs.#", "0"),
+					resource.TestMatchResourceAttr("data.pocketid_audit_logs.latest", "audit_logs.#", regexp.MustCompile(`^[01]$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,179 @@
+package datasources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/datasources"
+)
+
+// auditLogServer serves entries from /api/audit-logs/all in the given order,
+// pageSize entries per page, and records the pages requested.
+type auditLogServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	pages []int
+}
+
+func newAuditLogServer(t *testing.T, entries []client.AuditLog, pageSize int) *auditLogServer {
+	s := &auditLogServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/audit-logs/all", r.URL.Path)
+
+		page, err := strconv.Atoi(r.URL.Query().Get("pagination[page]"))
+		require.NoError(t, err)
+		s.mu.Lock()
+		s.pages = append(s.pages, page)
+		s.mu.Unlock()
+
+		start := min((page-1)*pageSize, len(entries))
+		end := min(start+pageSize, len(entries))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.PaginatedResponse[client.AuditLog]{
+			Data: entries[start:end],
+			Pagination: client.PaginationInfo{
+				TotalPages:   (len(entries) + pageSize - 1) / pageSize,
+				TotalItems:   len(entries),
+				CurrentPage:  page,
+				ItemsPerPage: pageSize,
+			},
+		})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *auditLogServer) requestedPages() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pages
+}
+
+// readAuditLogs reads the audit logs data source with the given configuration
+// attributes and returns the IDs of the entries in state.
+func readAuditLogs(t *testing.T, serverURL string, pageSize int, attrs map[string]tftypes.Value) ([]string, *datasource.ReadResponse) {
+	ctx := context.Background()
+
+	c, err := client.NewClient(serverURL, "test-token", false, 30, client.WithPageSize(pageSize))
+	require.NoError(t, err)
+
+	d := datasources.NewAuditLogsDataSource()
+	configureResp := &datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: c}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError())
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp
+	}
+
+	var logs types.List
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("audit_logs"), &logs).HasError())
+	ids := []string{}
+	for _, elem := range logs.Elements() {
+		ids = append(ids, elem.(types.Object).Attributes()["id"].(types.String).ValueString())
+	}
+	return ids, resp
+}
+
+// hourlyAuditLogs returns entries log-1 to log-n created an hour apart, newest
+// first, with log-1 created at 2026-01-01T10:00:00Z.
+func hourlyAuditLogs(n int) []client.AuditLog {
+	newest := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	entries := make([]client.AuditLog, n)
+	for i := range entries {
+		entries[i] = client.AuditLog{
+			ID:        "log-" + strconv.Itoa(i+1),
+			Event:     "SIGN_IN",
+			UserID:    "user-123",
+			IPAddress: "192.0.2.1",
+			CreatedAt: newest.Add(-time.Duration(i) * time.Hour).Format(time.RFC3339),
+		}
+	}
+	return entries
+}
+
+func TestAuditLogsDataSource_Read_Since(t *testing.T) {
+	server := newAuditLogServer(t, hourlyAuditLogs(6), 2)
+
+	ids, resp := readAuditLogs(t, server.URL, 2, map[string]tftypes.Value{
+		"since": tftypes.NewValue(tftypes.String, "2026-01-01T08:00:00Z"),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"log-1", "log-2", "log-3"}, ids)
+	// log-4 on page 2 is older than since, so page 3 is never requested.
+	assert.Equal(t, []int{1, 2}, server.requestedPages())
+}
+
+func TestAuditLogsDataSource_Read_Until(t *testing.T) {
+	server := newAuditLogServer(t, hourlyAuditLogs(6), 2)
+
+	ids, resp := readAuditLogs(t, server.URL, 2, map[string]tftypes.Value{
+		"since": tftypes.NewValue(tftypes.String, "2026-01-01T07:00:00Z"),
+		"until": tftypes.NewValue(tftypes.String, "2026-01-01T09:00:00Z"),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	// until is exclusive: log-2 created at 09:00 is skipped.
+	assert.Equal(t, []string{"log-3", "log-4"}, ids)
+}
+
+func TestAuditLogsDataSource_Read_MaxResults(t *testing.T) {
+	server := newAuditLogServer(t, hourlyAuditLogs(6), 2)
+
+	ids, resp := readAuditLogs(t, server.URL, 2, map[string]tftypes.Value{
+		"max_results": tftypes.NewValue(tftypes.Number, 3),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"log-1", "log-2", "log-3"}, ids)
+	assert.Equal(t, []int{1, 2}, server.requestedPages())
+}
+
+func TestAuditLogsDataSource_Read_InvalidCreatedAt(t *testing.T) {
+	entries := hourlyAuditLogs(2)
+	entries[1].CreatedAt = "yesterday"
+	server := newAuditLogServer(t, entries, 2)
+
+	_, resp := readAuditLogs(t, server.URL, 2, nil)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Unable to Read Audit Logs", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "log-2 has an invalid creation time")
+}
@@ -212,6 +212,387 @@ func TestClientsDataSource_Configure(t *testing.T) {
 	}
 }
 
+// Test API Keys Data Source
+func TestAPIKeysDataSource_Metadata(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewAPIKeysDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "pocketid_api_keys", resp.TypeName)
+}
+
+func TestAPIKeysDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewAPIKeysDataSource()
+
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	apiKeysAttr, ok := resp.Schema.Attributes["api_keys"]
+	assert.True(t, ok, "Schema should have api_keys attribute")
+
+	listAttr, ok := apiKeysAttr.(schema.ListNestedAttribute)
+	assert.True(t, ok, "api_keys should be a ListNestedAttribute")
+
+	expectedNestedAttributes := []string{
+		"id", "name", "description", "created_at", "last_used_at",
+		"expires_at", "expiration_email_sent", "expires_in_days",
+	}
+
+	for _, attr := range expectedNestedAttributes {
+		_, ok := listAttr.NestedObject.Attributes[attr]
+		assert.True(t, ok, "Nested object should have %s attribute", attr)
+	}
+}
+
+func TestAPIKeysDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid_client",
+			providerData: &client.Client{},
+			expectError:  false,
+		},
+		{
+			name:         "nil_provider_data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:          "invalid_provider_data_type",
+			providerData:  123,
+			expectError:   true,
+			errorContains: "Expected *client.Client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := datasources.NewAPIKeysDataSource()
+
+			configurable, ok := ds.(datasource.DataSourceWithConfigure)
+			require.True(t, ok)
+
+			req := datasource.ConfigureRequest{
+				ProviderData: tc.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			configurable.Configure(ctx, req, resp)
+
+			if tc.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+// Test Signup Tokens Data Source
+func TestSignupTokensDataSource_Metadata(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewSignupTokensDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "pocketid_signup_tokens", resp.TypeName)
+}
+
+func TestSignupTokensDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewSignupTokensDataSource()
+
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	signupTokensAttr, ok := resp.Schema.Attributes["signup_tokens"]
+	assert.True(t, ok, "Schema should have signup_tokens attribute")
+
+	listAttr, ok := signupTokensAttr.(schema.ListNestedAttribute)
+	assert.True(t, ok, "signup_tokens should be a ListNestedAttribute")
+
+	expectedNestedAttributes := []string{
+		"id", "usage_limit", "usage_count", "user_groups", "expires_at", "created_at",
+	}
+
+	for _, attr := range expectedNestedAttributes {
+		_, ok := listAttr.NestedObject.Attributes[attr]
+		assert.True(t, ok, "Nested object should have %s attribute", attr)
+	}
+}
+
+func TestSignupTokensDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid_client",
+			providerData: &client.Client{},
+			expectError:  false,
+		},
+		{
+			name:         "nil_provider_data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:          "invalid_provider_data_type",
+			providerData:  123,
+			expectError:   true,
+			errorContains: "Expected *client.Client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := datasources.NewSignupTokensDataSource()
+
+			configurable, ok := ds.(datasource.DataSourceWithConfigure)
+			require.True(t, ok)
+
+			req := datasource.ConfigureRequest{
+				ProviderData: tc.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			configurable.Configure(ctx, req, resp)
+
+			if tc.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+// Test User Passkeys Data Source
+func TestUserPasskeysDataSource_Metadata(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewUserPasskeysDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "pocketid_user_passkeys", resp.TypeName)
+}
+
+func TestUserPasskeysDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewUserPasskeysDataSource()
+
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	userIDAttr, ok := resp.Schema.Attributes["user_id"]
+	assert.True(t, ok, "Schema should have user_id attribute")
+	assert.True(t, userIDAttr.IsRequired())
+
+	passkeysAttr, ok := resp.Schema.Attributes["passkeys"]
+	assert.True(t, ok, "Schema should have passkeys attribute")
+
+	listAttr, ok := passkeysAttr.(schema.ListNestedAttribute)
+	assert.True(t, ok, "passkeys should be a ListNestedAttribute")
+
+	expectedNestedAttributes := []string{
+		"id", "name", "aaguid", "backup_eligible", "backup_state", "created_at", "last_used_at",
+	}
+
+	for _, attr := range expectedNestedAttributes {
+		_, ok := listAttr.NestedObject.Attributes[attr]
+		assert.True(t, ok, "Nested object should have %s attribute", attr)
+	}
+}
+
+func TestUserPasskeysDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid_client",
+			providerData: &client.Client{},
+			expectError:  false,
+		},
+		{
+			name:         "nil_provider_data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:          "invalid_provider_data_type",
+			providerData:  123,
+			expectError:   true,
+			errorContains: "Expected *client.Client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := datasources.NewUserPasskeysDataSource()
+
+			configurable, ok := ds.(datasource.DataSourceWithConfigure)
+			require.True(t, ok)
+
+			req := datasource.ConfigureRequest{
+				ProviderData: tc.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			configurable.Configure(ctx, req, resp)
+
+			if tc.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
+// Test User Authorized Clients Data Source
+func TestUserAuthorizedClientsDataSource_Metadata(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewUserAuthorizedClientsDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "pocketid_user_authorized_clients", resp.TypeName)
+}
+
+func TestUserAuthorizedClientsDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewUserAuthorizedClientsDataSource()
+
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	userIDAttr, ok := resp.Schema.Attributes["user_id"]
+	assert.True(t, ok, "Schema should have user_id attribute")
+	assert.True(t, userIDAttr.IsRequired())
+
+	authorizedClientsAttr, ok := resp.Schema.Attributes["authorized_clients"]
+	assert.True(t, ok, "Schema should have authorized_clients attribute")
+
+	listAttr, ok := authorizedClientsAttr.(schema.ListNestedAttribute)
+	assert.True(t, ok, "authorized_clients should be a ListNestedAttribute")
+
+	expectedNestedAttributes := []string{
+		"client_id", "client_name", "scopes", "last_used_at",
+	}
+
+	for _, attr := range expectedNestedAttributes {
+		_, ok := listAttr.NestedObject.Attributes[attr]
+		assert.True(t, ok, "Nested object should have %s attribute", attr)
+	}
+}
+
+func TestUserAuthorizedClientsDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid_client",
+			providerData: &client.Client{},
+			expectError:  false,
+		},
+		{
+			name:         "nil_provider_data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:          "invalid_provider_data_type",
+			providerData:  123,
+			expectError:   true,
+			errorContains: "Expected *client.Client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := datasources.NewUserAuthorizedClientsDataSource()
+
+			configurable, ok := ds.(datasource.DataSourceWithConfigure)
+			require.True(t, ok)
+
+			req := datasource.ConfigureRequest{
+				ProviderData: tc.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			configurable.Configure(ctx, req, resp)
+
+			if tc.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
 // Test User Data Source
 func TestUserDataSource_Metadata(t *testing.T) {
 	ctx := context.Background()
@@ -641,6 +1022,106 @@ func TestGroupsDataSource_Configure(t *testing.T) {
 	}
 }
 
+// Test Audit Logs Data Source
+func TestAuditLogsDataSource_Metadata(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewAuditLogsDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "pocketid_audit_logs", resp.TypeName)
+}
+
+func TestAuditLogsDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	ds := datasources.NewAuditLogsDataSource()
+
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	for _, attr := range []string{"user_id", "event", "client_name", "since", "until", "max_results"} {
+		filterAttr, ok := resp.Schema.Attributes[attr]
+		assert.True(t, ok, "Schema should have %s attribute", attr)
+		assert.True(t, filterAttr.IsOptional(), "%s should be optional", attr)
+	}
+
+	auditLogsAttr, ok := resp.Schema.Attributes["audit_logs"]
+	assert.True(t, ok, "Schema should have audit_logs attribute")
+
+	listAttr, ok := auditLogsAttr.(schema.ListNestedAttribute)
+	assert.True(t, ok, "audit_logs should be a ListNestedAttribute")
+
+	expectedNestedAttributes := []string{
+		"id", "event", "user_id", "username", "ip_address", "user_agent",
+		"country", "city", "client_name", "created_at",
+	}
+
+	for _, attr := range expectedNestedAttributes {
+		_, ok := listAttr.NestedObject.Attributes[attr]
+		assert.True(t, ok, "Nested object should have %s attribute", attr)
+	}
+}
+
+func TestAuditLogsDataSource_Configure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		providerData  interface{}
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:         "valid_client",
+			providerData: &client.Client{},
+			expectError:  false,
+		},
+		{
+			name:         "nil_provider_data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:          "invalid_provider_data_type",
+			providerData:  123,
+			expectError:   true,
+			errorContains: "Expected *client.Client",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := datasources.NewAuditLogsDataSource()
+
+			configurable, ok := ds.(datasource.DataSourceWithConfigure)
+			require.True(t, ok)
+
+			req := datasource.ConfigureRequest{
+				ProviderData: tc.providerData,
+			}
+			resp := &datasource.ConfigureResponse{}
+
+			configurable.Configure(ctx, req, resp)
+
+			if tc.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+		})
+	}
+}
+
 // Test that all data sources have descriptions
 func TestDataSources_HaveDescriptions(t *testing.T) {
 	ctx := context.Background()
@@ -652,6 +1133,9 @@ func TestDataSources_HaveDescriptions(t *testing.T) {
 		datasources.NewUsersDataSource(),
 		datasources.NewGroupDataSource(),
 		datasources.NewGroupsDataSource(),
+		datasources.NewUserPasskeysDataSource(),
+		datasources.NewUserAuthorizedClientsDataSource(),
+		datasources.NewAuditLogsDataSource(),
 	}
 
 	for _, ds := range dataSources {
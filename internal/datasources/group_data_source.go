@@ -113,19 +113,16 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Get all groups
-	groupsResp, err := d.client.ListUserGroups()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Groups",
-			err.Error(),
-		)
-		return
-	}
-
-	// Find the matching group
+	// Find the matching group, fetching pages until it is found
 	var foundGroup *client.UserGroup
-	for _, group := range groupsResp.Data {
+	for group, err := range d.client.IterUserGroups(ctx) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Groups",
+				err.Error(),
+			)
+			return
+		}
 		if (!data.ID.IsNull() && group.ID == data.ID.ValueString()) ||
 			(!data.Name.IsNull() && group.Name == data.Name.ValueString()) {
 			foundGroup = &group
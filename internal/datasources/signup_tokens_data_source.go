@@ -0,0 +1,160 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &signupTokensDataSource{}
+	_ datasource.DataSourceWithConfigure = &signupTokensDataSource{}
+)
+
+// NewSignupTokensDataSource creates a new signup tokens data source.
+func NewSignupTokensDataSource() datasource.DataSource {
+	return &signupTokensDataSource{}
+}
+
+// signupTokensDataSource is the data source implementation.
+type signupTokensDataSource struct {
+	client *client.Client
+}
+
+// signupTokensDataSourceModel describes the data source data model.
+type signupTokensDataSourceModel struct {
+	SignupTokens []signupTokenModel `tfsdk:"signup_tokens"`
+}
+
+// signupTokenModel describes the signup token data model.
+type signupTokenModel struct {
+	ID         types.String `tfsdk:"id"`
+	UsageLimit types.Int64  `tfsdk:"usage_limit"`
+	UsageCount types.Int64  `tfsdk:"usage_count"`
+	UserGroups types.List   `tfsdk:"user_groups"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *signupTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signup_tokens"
+}
+
+// Schema defines the schema for the data source.
+func (d *signupTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about all Pocket-ID signup tokens. The tokens themselves are not exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"signup_tokens": schema.ListNestedAttribute{
+				Description: "List of all signup tokens.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the signup token.",
+							Computed:    true,
+						},
+						"usage_limit": schema.Int64Attribute{
+							Description: "How many accounts can be created with the token.",
+							Computed:    true,
+						},
+						"usage_count": schema.Int64Attribute{
+							Description: "How many accounts have been created with the token.",
+							Computed:    true,
+						},
+						"user_groups": schema.ListAttribute{
+							Description: "IDs of the user groups that accounts created with the token are added to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "The expiration time of the token in RFC3339 format.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation time of the token in RFC3339 format. Null when not reported by the server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *signupTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *signupTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data signupTokensDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all signup tokens
+	tokensResp, err := d.client.ListSignupTokens(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Signup Tokens",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Retrieved signup tokens", map[string]interface{}{
+		"count": len(tokensResp.Data),
+	})
+
+	// Map response body to model
+	data.SignupTokens = make([]signupTokenModel, len(tokensResp.Data))
+	for i, token := range tokensResp.Data {
+		groupIDs := make([]string, len(token.UserGroups))
+		for j, group := range token.UserGroups {
+			groupIDs[j] = group.ID
+		}
+		userGroups, diags := types.ListValueFrom(ctx, types.StringType, groupIDs)
+		resp.Diagnostics.Append(diags...)
+
+		data.SignupTokens[i] = signupTokenModel{
+			ID:         types.StringValue(token.ID),
+			UsageLimit: types.Int64Value(token.UsageLimit),
+			UsageCount: types.Int64Value(token.UsageCount),
+			UserGroups: userGroups,
+			ExpiresAt:  types.StringValue(token.ExpiresAt),
+			CreatedAt:  optionalStringValue(token.CreatedAt),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+//go:build acc
+// +build acc
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSignupTokensDataSource_ReadAll(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignupTokensDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("usage_limit", "3"),
+					resource.TestCheckOutput("usage_count", "0"),
+					resource.TestCheckOutput("in_group", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignupTokensDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "pocketid_group" "test" {
+  name          = %[1]q
+  friendly_name = %[1]q
+}
+
+resource "pocketid_signup_token" "test" {
+  ttl         = "24h"
+  usage_limit = 3
+  user_groups = [pocketid_group.test.id]
+}
+
+data "pocketid_signup_tokens" "all" {
+  depends_on = [pocketid_signup_token.test]
+}
+
+locals {
+  test_token = one([for t in data.pocketid_signup_tokens.all.signup_tokens : t if t.id == pocketid_signup_token.test.id])
+}
+
+output "usage_limit" {
+  value = tostring(local.test_token.usage_limit)
+}
+
+output "usage_count" {
+  value = tostring(local.test_token.usage_count)
+}
+
+output "in_group" {
+  value = tostring(contains(local.test_token.user_groups, pocketid_group.test.id))
+}
+`, rName)
+}
@@ -0,0 +1,159 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userAuthorizedClientsDataSource{}
+	_ datasource.DataSourceWithConfigure = &userAuthorizedClientsDataSource{}
+)
+
+// NewUserAuthorizedClientsDataSource creates a new user authorized clients data source.
+func NewUserAuthorizedClientsDataSource() datasource.DataSource {
+	return &userAuthorizedClientsDataSource{}
+}
+
+// userAuthorizedClientsDataSource is the data source implementation.
+type userAuthorizedClientsDataSource struct {
+	client *client.Client
+}
+
+// userAuthorizedClientsDataSourceModel describes the data source data model.
+type userAuthorizedClientsDataSourceModel struct {
+	UserID            types.String            `tfsdk:"user_id"`
+	ClientID          types.String            `tfsdk:"client_id"`
+	AuthorizedClients []authorizedClientModel `tfsdk:"authorized_clients"`
+}
+
+// authorizedClientModel describes the authorized client data model.
+type authorizedClientModel struct {
+	ClientID   types.String `tfsdk:"client_id"`
+	ClientName types.String `tfsdk:"client_name"`
+	Scopes     types.List   `tfsdk:"scopes"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *userAuthorizedClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_authorized_clients"
+}
+
+// Schema defines the schema for the data source.
+func (d *userAuthorizedClientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the OIDC clients a Pocket-ID user has authorized, with the scopes the user consented to.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Only return the authorization of the OIDC client with this ID, e.g. to check whether the user has authorized it.",
+				Optional:    true,
+			},
+			"authorized_clients": schema.ListNestedAttribute{
+				Description: "List of the OIDC clients the user has authorized.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"client_id": schema.StringAttribute{
+							Description: "The ID of the OIDC client.",
+							Computed:    true,
+						},
+						"client_name": schema.StringAttribute{
+							Description: "The name of the OIDC client.",
+							Computed:    true,
+						},
+						"scopes": schema.ListAttribute{
+							Description: "The scopes the user consented to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"last_used_at": schema.StringAttribute{
+							Description: "The time the user last signed in to the client, in RFC3339 format. Null when not reported by the server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userAuthorizedClientsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userAuthorizedClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userAuthorizedClientsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizedResp, err := d.client.ListUserAuthorizedClients(ctx, data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Authorized Clients",
+			fmt.Sprintf("Unable to read authorized clients of user %s: %s", data.UserID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Retrieved user authorized clients", map[string]interface{}{
+		"user_id": data.UserID.ValueString(),
+		"count":   len(authorizedResp.Data),
+	})
+
+	// Map response body to model
+	data.AuthorizedClients = make([]authorizedClientModel, 0, len(authorizedResp.Data))
+	for _, authorized := range authorizedResp.Data {
+		if !data.ClientID.IsNull() && authorized.Client.ID != data.ClientID.ValueString() {
+			continue
+		}
+
+		scopes, diags := types.ListValueFrom(ctx, types.StringType, strings.Fields(authorized.Scope))
+		resp.Diagnostics.Append(diags...)
+
+		data.AuthorizedClients = append(data.AuthorizedClients, authorizedClientModel{
+			ClientID:   types.StringValue(authorized.Client.ID),
+			ClientName: types.StringValue(authorized.Client.Name),
+			Scopes:     scopes,
+			LastUsedAt: optionalStringValue(authorized.LastUsedAt),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+//go:build acc
+// +build acc
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserAuthorizedClientsDataSource_noAuthorizations(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A user provisioned by Terraform has not signed in to any client yet.
+			{
+				Config: fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_client" "test" {
+  name          = %[1]q
+  callback_urls = ["https://%[1]s.example.com/callback"]
+}
+
+data "pocketid_user_authorized_clients" "all" {
+  user_id = pocketid_user.test.id
+}
+
+data "pocketid_user_authorized_clients" "filtered" {
+  user_id   = pocketid_user.test.id
+  client_id = pocketid_client.test.id
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pocketid_user_authorized_clients.all", "authorized_clients.#", "0"),
+					resource.TestCheckResourceAttr("data.pocketid_user_authorized_clients.filtered", "authorized_clients.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -168,7 +168,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tflog.Debug(ctx, "Reading user data source by ID", map[string]any{
 			"id": config.ID.ValueString(),
 		})
-		userResp, err = d.client.GetUser(config.ID.ValueString())
+		userResp, err = d.client.GetUser(ctx, config.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading user",
@@ -177,22 +177,20 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			return
 		}
 	} else {
-		// Lookup by username - we need to list all users and find the matching one
+		// Lookup by username - we need to list users and find the matching one
 		tflog.Debug(ctx, "Reading user data source by username", map[string]any{
 			"username": config.Username.ValueString(),
 		})
 
-		usersResp, err := d.client.ListUsers()
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error listing users",
-				"Could not list users to find username "+config.Username.ValueString()+": "+err.Error(),
-			)
-			return
-		}
-
-		// Find the user with matching username
-		for _, user := range usersResp.Data {
+		// Pages are fetched lazily, so the lookup stops at the first match
+		for user, err := range d.client.IterUsers(ctx) {
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error listing users",
+					"Could not list users to find username "+config.Username.ValueString()+": "+err.Error(),
+				)
+				return
+			}
 			if user.Username == config.Username.ValueString() {
 				userResp = &user
 				break
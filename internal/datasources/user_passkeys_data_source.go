@@ -0,0 +1,163 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userPasskeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &userPasskeysDataSource{}
+)
+
+// NewUserPasskeysDataSource creates a new user passkeys data source.
+func NewUserPasskeysDataSource() datasource.DataSource {
+	return &userPasskeysDataSource{}
+}
+
+// userPasskeysDataSource is the data source implementation.
+type userPasskeysDataSource struct {
+	client *client.Client
+}
+
+// userPasskeysDataSourceModel describes the data source data model.
+type userPasskeysDataSourceModel struct {
+	UserID   types.String   `tfsdk:"user_id"`
+	Passkeys []passkeyModel `tfsdk:"passkeys"`
+}
+
+// passkeyModel describes the passkey data model.
+type passkeyModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	AAGUID         types.String `tfsdk:"aaguid"`
+	BackupEligible types.Bool   `tfsdk:"backup_eligible"`
+	BackupState    types.Bool   `tfsdk:"backup_state"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	LastUsedAt     types.String `tfsdk:"last_used_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *userPasskeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_passkeys"
+}
+
+// Schema defines the schema for the data source.
+func (d *userPasskeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the passkeys (WebAuthn credentials) a Pocket-ID user has registered, e.g. to check whether a provisioned user has completed registration.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+			},
+			"passkeys": schema.ListNestedAttribute{
+				Description: "List of the user's passkeys. Empty when the user has not registered one yet.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the passkey.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name the user gave the passkey.",
+							Computed:    true,
+						},
+						"aaguid": schema.StringAttribute{
+							Description: "The AAGUID identifying the authenticator model. Null when not reported by the authenticator.",
+							Computed:    true,
+						},
+						"backup_eligible": schema.BoolAttribute{
+							Description: "Whether the passkey can be synced to other devices.",
+							Computed:    true,
+						},
+						"backup_state": schema.BoolAttribute{
+							Description: "Whether the passkey is currently synced to other devices.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The registration time of the passkey in RFC3339 format. Null when not reported by the server.",
+							Computed:    true,
+						},
+						"last_used_at": schema.StringAttribute{
+							Description: "The time the passkey was last used to sign in, in RFC3339 format. Null when it has never been used.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userPasskeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userPasskeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userPasskeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := d.client.ListUserWebauthnCredentials(ctx, data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Passkeys",
+			fmt.Sprintf("Unable to read passkeys of user %s: %s", data.UserID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Retrieved user passkeys", map[string]interface{}{
+		"user_id": data.UserID.ValueString(),
+		"count":   len(credentials),
+	})
+
+	// Map response body to model
+	data.Passkeys = make([]passkeyModel, len(credentials))
+	for i, credential := range credentials {
+		data.Passkeys[i] = passkeyModel{
+			ID:             types.StringValue(credential.ID),
+			Name:           types.StringValue(credential.Name),
+			AAGUID:         optionalStringValue(credential.AAGUID),
+			BackupEligible: types.BoolValue(credential.BackupEligible),
+			BackupState:    types.BoolValue(credential.BackupState),
+			CreatedAt:      optionalStringValue(credential.CreatedAt),
+			LastUsedAt:     optionalStringValue(credential.LastUsedAt),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
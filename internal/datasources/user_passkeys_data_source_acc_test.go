@@ -0,0 +1,40 @@
+//go:build acc
+// +build acc
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserPasskeysDataSource_noPasskeys(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A user provisioned by Terraform has not registered a passkey yet.
+			{
+				Config: fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+data "pocketid_user_passkeys" "test" {
+  user_id = pocketid_user.test.id
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pocketid_user_passkeys.test", "user_id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttr("data.pocketid_user_passkeys.test", "passkeys.#", "0"),
+				),
+			},
+		},
+	})
+}
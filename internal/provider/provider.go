@@ -2,13 +2,20 @@
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -41,10 +48,26 @@ type pocketIDProvider struct {
 
 // pocketIDProviderModel maps provider schema data to a Go type.
 type pocketIDProviderModel struct {
-	BaseURL       types.String `tfsdk:"base_url"`
-	APIToken      types.String `tfsdk:"api_token"`
-	SkipTLSVerify types.Bool   `tfsdk:"skip_tls_verify"`
-	Timeout       types.Int64  `tfsdk:"timeout"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	APIToken              types.String  `tfsdk:"api_token"`
+	SkipTLSVerify         types.Bool    `tfsdk:"skip_tls_verify"`
+	Timeout               types.Int64   `tfsdk:"timeout"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin          types.Int64   `tfsdk:"retry_wait_min"`
+	RetryWaitMax          types.Int64   `tfsdk:"retry_wait_max"`
+	RetryJitter           types.Bool    `tfsdk:"retry_jitter"`
+	RetryDeadline         types.Int64   `tfsdk:"retry_deadline"`
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestCache          types.Bool    `tfsdk:"request_cache"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	ClientCertPEM         types.String  `tfsdk:"client_cert_pem"`
+	ClientCertFile        types.String  `tfsdk:"client_cert_file"`
+	ClientKeyPEM          types.String  `tfsdk:"client_key_pem"`
+	ClientKeyFile         types.String  `tfsdk:"client_key_file"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	Headers               types.Map     `tfsdk:"headers"`
 }
 
 // Metadata returns the provider type name.
@@ -59,7 +82,7 @@ func (p *pocketIDProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 		Description: "Interact with Pocket-ID OIDC provider. Pocket-ID is a simple and easy-to-use OIDC provider\nthat allows users to authenticate with their passkeys to your services.",
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				Description: "Base URL of the Pocket-ID instance. Can also be set via POCKETID_BASE_URL environment variable.",
+				Description: "Base URL of the Pocket-ID instance, optionally including a sub-path such as https://example.com/pocket-id. Can also be set via POCKETID_BASE_URL environment variable.",
 				Optional:    true,
 			},
 			"api_token": schema.StringAttribute{
@@ -75,6 +98,111 @@ func (p *pocketIDProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Description: "HTTP client timeout in seconds. Default is 30.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for a failed request. Default is 3. Set to 0 to disable retries. " +
+					"Requests that are not idempotent (such as creates) are only retried when they never reached the server or were rate limited.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Description: "Backoff in seconds before the first retry. The backoff doubles on every retry. Default is 1.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Description: "Maximum backoff in seconds between two retries. Default is 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_jitter": schema.BoolAttribute{
+				Description: "Randomize each retry backoff so parallel requests do not retry at the same time. Default is true.",
+				Optional:    true,
+			},
+			"retry_deadline": schema.Int64Attribute{
+				Description: "Total time in seconds a request may spend retrying. A retry that would start after the deadline is not attempted. Default is 0 (no deadline).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to Pocket-ID, shared by all resources and data sources. " +
+					"Requests above the limit are delayed and sent evenly spaced. Default is 0 (unlimited).",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests in flight to Pocket-ID at the same time, shared by all resources and data sources. Default is 0 (unlimited).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_cache": schema.BoolAttribute{
+				Description: "Cache API read responses in memory for the duration of a Terraform run and send identical concurrent reads only once. " +
+					"The cache is cleared whenever the provider changes anything in Pocket-ID. Default is false.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system certificate pool, for a Pocket-ID instance behind an internal CA. " +
+					"Can also be set via POCKETID_CA_CERT_PEM environment variable. Conflicts with ca_cert_file.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file containing PEM encoded CA certificates to trust in addition to the system certificate pool. " +
+					"Can also be set via POCKETID_CA_CERT_FILE environment variable. Conflicts with ca_cert_pem.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to servers that require mutual TLS. Requires a client key. " +
+					"Can also be set via POCKETID_CLIENT_CERT_PEM environment variable. Conflicts with client_cert_file.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a file containing the PEM encoded client certificate presented to servers that require mutual TLS. " +
+					"Can also be set via POCKETID_CLIENT_CERT_FILE environment variable. Conflicts with client_cert_pem.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. " +
+					"Can also be set via POCKETID_CLIENT_KEY_PEM environment variable. Conflicts with client_key_file.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the PEM encoded private key of the client certificate. " +
+					"Can also be set via POCKETID_CLIENT_KEY_FILE environment variable. Conflicts with client_key_pem.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the HTTP or HTTPS proxy used to reach Pocket-ID. Can also be set via POCKETID_PROXY_URL environment variable. " +
+					"Defaults to the proxy configured by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every request, for example to authenticate with a gateway in front of Pocket-ID. " +
+					"They cannot replace the headers set by the provider, such as the API key.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -165,15 +293,115 @@ func (p *pocketIDProvider) Configure(ctx context.Context, req provider.Configure
 		timeout = config.Timeout.ValueInt64()
 	}
 
+	retryPolicy := client.DefaultRetryPolicy()
+	if !config.MaxRetries.IsNull() {
+		retryPolicy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryWaitMin.IsNull() {
+		retryPolicy.WaitMin = time.Duration(config.RetryWaitMin.ValueInt64()) * time.Second
+	}
+	if !config.RetryWaitMax.IsNull() {
+		retryPolicy.WaitMax = time.Duration(config.RetryWaitMax.ValueInt64()) * time.Second
+	}
+	if !config.RetryJitter.IsNull() {
+		retryPolicy.Jitter = config.RetryJitter.ValueBool()
+	}
+	if !config.RetryDeadline.IsNull() {
+		retryPolicy.Deadline = time.Duration(config.RetryDeadline.ValueInt64()) * time.Second
+	}
+
+	if retryPolicy.WaitMin > retryPolicy.WaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Backoff",
+			"The retry_wait_min value must not be greater than retry_wait_max.",
+		)
+		return
+	}
+
+	maxRequestsPerSecond := float64(0)
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = config.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	maxConcurrentRequests := int64(0)
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = config.MaxConcurrentRequests.ValueInt64()
+	}
+
+	var clientOpts []client.Option
+
+	caCertPEM, err := resolvePEM(config.CACertPEM, config.CACertFile, "POCKETID_CA_CERT_PEM", "POCKETID_CA_CERT_FILE")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Unable to Read CA Certificate", err.Error())
+	}
+	clientCertPEM, err := resolvePEM(config.ClientCertPEM, config.ClientCertFile, "POCKETID_CLIENT_CERT_PEM", "POCKETID_CLIENT_CERT_FILE")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("client_cert_file"), "Unable to Read Client Certificate", err.Error())
+	}
+	clientKeyPEM, err := resolvePEM(config.ClientKeyPEM, config.ClientKeyFile, "POCKETID_CLIENT_KEY_PEM", "POCKETID_CLIENT_KEY_FILE")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("client_key_file"), "Unable to Read Client Key", err.Error())
+	}
+	if (len(clientCertPEM) == 0) != (len(clientKeyPEM) == 0) {
+		resp.Diagnostics.AddError(
+			"Incomplete Client Certificate",
+			"Mutual TLS requires both a client certificate (client_cert_pem or client_cert_file) "+
+				"and a client key (client_key_pem or client_key_file).",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proxyURL := os.Getenv("POCKETID_PROXY_URL")
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+	if proxyURL != "" {
+		clientOpts = append(clientOpts, client.WithProxyURL(proxyURL))
+	}
+
+	if !config.Headers.IsNull() {
+		headers := make(map[string]string, len(config.Headers.Elements()))
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clientOpts = append(clientOpts, client.WithHeaders(headers))
+	}
+
+	if len(caCertPEM) > 0 {
+		clientOpts = append(clientOpts, client.WithCACertPEM(caCertPEM))
+	}
+	if len(clientCertPEM) > 0 {
+		clientOpts = append(clientOpts, client.WithClientCertificatePEM(clientCertPEM, clientKeyPEM))
+	}
+	if !config.RequestCache.IsNull() && config.RequestCache.ValueBool() {
+		clientOpts = append(clientOpts, client.WithResponseCache())
+	}
+
 	ctx = tflog.SetField(ctx, "pocketid_base_url", baseURL)
 	ctx = tflog.SetField(ctx, "pocketid_skip_tls_verify", skipTLSVerify)
 	ctx = tflog.SetField(ctx, "pocketid_timeout", timeout)
+	ctx = tflog.SetField(ctx, "pocketid_max_retries", retryPolicy.MaxRetries)
+	ctx = tflog.SetField(ctx, "pocketid_max_requests_per_second", maxRequestsPerSecond)
+	ctx = tflog.SetField(ctx, "pocketid_max_concurrent_requests", maxConcurrentRequests)
+	ctx = tflog.SetField(ctx, "pocketid_request_cache", config.RequestCache.ValueBool())
+	ctx = tflog.SetField(ctx, "pocketid_custom_ca", len(caCertPEM) > 0)
+	ctx = tflog.SetField(ctx, "pocketid_client_certificate", len(clientCertPEM) > 0)
+	ctx = tflog.SetField(ctx, "pocketid_proxy_url", redactURL(proxyURL))
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pocketid_api_token")
 
 	tflog.Debug(ctx, "Creating Pocket-ID client")
 
 	// Create a new Pocket-ID client using the configuration values
-	client, err := client.NewClient(baseURL, apiToken, skipTLSVerify, timeout)
+	clientOpts = append(clientOpts,
+		client.WithRetryPolicy(retryPolicy),
+		client.WithRateLimit(maxRequestsPerSecond),
+		client.WithMaxConcurrentRequests(int(maxConcurrentRequests)),
+	)
+	client, err := client.NewClient(baseURL, apiToken, skipTLSVerify, timeout, clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Pocket-ID Client",
@@ -184,6 +412,16 @@ func (p *pocketIDProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Detect the server version so resources can reject features the server
+	// does not support. A failure is not fatal: the checks are skipped and
+	// the first API call reports any connection problem.
+	serverVersion, err := client.DetectServerVersion(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to detect Pocket-ID server version, skipping capability checks", map[string]any{"error": err.Error()})
+	} else {
+		tflog.Info(ctx, "Detected Pocket-ID server version", map[string]any{"version": serverVersion.String()})
+	}
+
 	// Make the Pocket-ID client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -192,6 +430,45 @@ func (p *pocketIDProvider) Configure(ctx context.Context, req provider.Configure
 	tflog.Info(ctx, "Configured Pocket-ID client", map[string]any{"success": true})
 }
 
+// resolvePEM returns the PEM content configured either inline or as a file
+// path. Configuration values take precedence over the environment variables,
+// and inline content over a file.
+func resolvePEM(pemValue, fileValue types.String, pemEnv, fileEnv string) ([]byte, error) {
+	content := os.Getenv(pemEnv)
+	file := os.Getenv(fileEnv)
+	if !pemValue.IsNull() || !fileValue.IsNull() {
+		content = pemValue.ValueString()
+		file = fileValue.ValueString()
+	}
+
+	if content != "" {
+		return []byte(content), nil
+	}
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(file) // #nosec G304 - path is provided by the practitioner
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", file, err)
+	}
+	return data, nil
+}
+
+// redactURL returns rawURL for logging, with the password of any user:password@
+// credentials replaced. A URL that cannot be parsed is not logged at all, as
+// it could still contain credentials.
+func redactURL(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "[invalid URL]"
+	}
+	return u.Redacted()
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *pocketIDProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -202,6 +479,11 @@ func (p *pocketIDProvider) DataSources(_ context.Context) []func() datasource.Da
 		datasources.NewGroupDataSource,
 		datasources.NewGroupsDataSource,
 		datasources.NewApplicationConfigDataSource,
+		datasources.NewAPIKeysDataSource,
+		datasources.NewSignupTokensDataSource,
+		datasources.NewUserPasskeysDataSource,
+		datasources.NewUserAuthorizedClientsDataSource,
+		datasources.NewAuditLogsDataSource,
 	}
 }
 
@@ -215,5 +497,18 @@ func (p *pocketIDProvider) Resources(_ context.Context) []func() resource.Resour
 		resources.NewApplicationConfigResource,
 		resources.NewScimServiceProviderResource,
 		resources.NewLdapSyncResource,
+		resources.NewAPIKeyResource,
+		resources.NewClientLogoResource,
+		resources.NewApplicationImagesResource,
+		resources.NewUserProfilePictureResource,
+		resources.NewSignupTokenResource,
+		resources.NewUserGroupMembershipResource,
+		resources.NewClientAllowedGroupResource,
+		resources.NewUserCustomClaimResource,
+		resources.NewGroupCustomClaimResource,
+		resources.NewUserPasskeyRevocationResource,
+		resources.NewClientAuthorizationRevocationResource,
+		resources.NewOneTimeAccessEmailResource,
+		resources.NewTestEmailResource,
 	}
 }
@@ -116,6 +116,22 @@ func TestProvider_Schema(t *testing.T) {
 		"api_token",
 		"skip_tls_verify",
 		"timeout",
+		"max_retries",
+		"retry_wait_min",
+		"retry_wait_max",
+		"retry_jitter",
+		"retry_deadline",
+		"max_requests_per_second",
+		"max_concurrent_requests",
+		"request_cache",
+		"ca_cert_pem",
+		"ca_cert_file",
+		"client_cert_pem",
+		"client_cert_file",
+		"client_key_pem",
+		"client_key_file",
+		"proxy_url",
+		"headers",
 	}
 
 	for _, attrName := range expectedAttributes {
@@ -143,6 +159,24 @@ func TestProvider_Schema(t *testing.T) {
 	assert.True(t, timeoutAttr.Optional)
 }
 
+// providerConfigValue builds a provider configuration object from the given
+// attribute values. Attributes of the schema that are not set are null.
+func providerConfigValue(ctx context.Context, t *testing.T, s schema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	objectType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attrs)
+}
+
 func TestProvider_Configure(t *testing.T) {
 	// Save current env vars
 	originalBaseURL := os.Getenv("POCKETID_BASE_URL")
@@ -285,6 +319,101 @@ func TestProvider_Configure(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "custom_retry_policy",
+			config: map[string]tftypes.Value{
+				"base_url":       tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":      tftypes.NewValue(tftypes.String, "test-token"),
+				"max_retries":    tftypes.NewValue(tftypes.Number, 5),
+				"retry_wait_min": tftypes.NewValue(tftypes.Number, 2),
+				"retry_wait_max": tftypes.NewValue(tftypes.Number, 10),
+				"retry_jitter":   tftypes.NewValue(tftypes.Bool, false),
+				"retry_deadline": tftypes.NewValue(tftypes.Number, 60),
+			},
+			expectError: false,
+		},
+		{
+			name: "retry_wait_min_greater_than_max",
+			config: map[string]tftypes.Value{
+				"base_url":       tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":      tftypes.NewValue(tftypes.String, "test-token"),
+				"retry_wait_min": tftypes.NewValue(tftypes.Number, 10),
+				"retry_wait_max": tftypes.NewValue(tftypes.Number, 5),
+			},
+			expectError:   true,
+			errorContains: []string{"Invalid Retry Backoff"},
+		},
+		{
+			name: "request_limits",
+			config: map[string]tftypes.Value{
+				"base_url":                tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":               tftypes.NewValue(tftypes.String, "test-token"),
+				"max_requests_per_second": tftypes.NewValue(tftypes.Number, 2.5),
+				"max_concurrent_requests": tftypes.NewValue(tftypes.Number, 4),
+			},
+			expectError: false,
+		},
+		{
+			name: "request_cache_enabled",
+			config: map[string]tftypes.Value{
+				"base_url":      tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":     tftypes.NewValue(tftypes.String, "test-token"),
+				"request_cache": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: false,
+		},
+		{
+			name: "ca_cert_file_missing",
+			config: map[string]tftypes.Value{
+				"base_url":     tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":    tftypes.NewValue(tftypes.String, "test-token"),
+				"ca_cert_file": tftypes.NewValue(tftypes.String, "/nonexistent/ca.pem"),
+			},
+			expectError:   true,
+			errorContains: []string{"Unable to Read CA Certificate"},
+		},
+		{
+			name: "invalid_ca_cert_pem",
+			config: map[string]tftypes.Value{
+				"base_url":    tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":   tftypes.NewValue(tftypes.String, "test-token"),
+				"ca_cert_pem": tftypes.NewValue(tftypes.String, "not a certificate"),
+			},
+			expectError:   true,
+			errorContains: []string{"Unable to Create Pocket-ID Client"},
+		},
+		{
+			name: "client_cert_without_key",
+			config: map[string]tftypes.Value{
+				"base_url":        tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token":       tftypes.NewValue(tftypes.String, "test-token"),
+				"client_cert_pem": tftypes.NewValue(tftypes.String, "-----BEGIN CERTIFICATE-----"),
+			},
+			expectError:   true,
+			errorContains: []string{"Incomplete Client Certificate"},
+		},
+		{
+			name: "proxy_and_headers",
+			config: map[string]tftypes.Value{
+				"base_url":  tftypes.NewValue(tftypes.String, "https://pocketid.example.com/pocket-id/"),
+				"api_token": tftypes.NewValue(tftypes.String, "test-token"),
+				"proxy_url": tftypes.NewValue(tftypes.String, "http://proxy.example.com:3128"),
+				"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"CF-Access-Client-Id": tftypes.NewValue(tftypes.String, "client-id"),
+				}),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid_proxy_url",
+			config: map[string]tftypes.Value{
+				"base_url":  tftypes.NewValue(tftypes.String, "https://pocketid.example.com"),
+				"api_token": tftypes.NewValue(tftypes.String, "test-token"),
+				"proxy_url": tftypes.NewValue(tftypes.String, "not a url"),
+			},
+			expectError:   true,
+			errorContains: []string{"invalid proxy URL"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -308,14 +437,7 @@ func TestProvider_Configure(t *testing.T) {
 			require.False(t, schemaResp.Diagnostics.HasError())
 
 			// Create config
-			configValue := tftypes.NewValue(tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"base_url":        tftypes.String,
-					"api_token":       tftypes.String,
-					"skip_tls_verify": tftypes.Bool,
-					"timeout":         tftypes.Number,
-				},
-			}, tc.config)
+			configValue := providerConfigValue(ctx, t, schemaResp.Schema, tc.config)
 
 			config := tfsdk.Config{
 				Raw:    configValue,
@@ -357,8 +479,8 @@ func TestProvider_DataSources(t *testing.T) {
 
 	dataSources := p.DataSources(ctx)
 
-	// Should have 7 data sources
-	assert.Len(t, dataSources, 7)
+	// Should have 12 data sources
+	assert.Len(t, dataSources, 12)
 
 	// Verify each data source can be created
 	for i, dsFunc := range dataSources {
@@ -375,8 +497,8 @@ func TestProvider_Resources(t *testing.T) {
 
 	resources := p.Resources(ctx)
 
-	// Should have 7 resources
-	assert.Len(t, resources, 7)
+	// Should have 20 resources
+	assert.Len(t, resources, 20)
 
 	// Verify each resource can be created
 	for i, resFunc := range resources {
@@ -415,19 +537,7 @@ func TestProvider_Configure_EdgeCases(t *testing.T) {
 		require.False(t, schemaResp.Diagnostics.HasError())
 
 		// Create config with all null values
-		configValue := tftypes.NewValue(tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"base_url":        tftypes.String,
-				"api_token":       tftypes.String,
-				"skip_tls_verify": tftypes.Bool,
-				"timeout":         tftypes.Number,
-			},
-		}, map[string]tftypes.Value{
-			"base_url":        tftypes.NewValue(tftypes.String, nil),
-			"api_token":       tftypes.NewValue(tftypes.String, nil),
-			"skip_tls_verify": tftypes.NewValue(tftypes.Bool, nil),
-			"timeout":         tftypes.NewValue(tftypes.Number, nil),
-		})
+		configValue := providerConfigValue(ctx, t, schemaResp.Schema, nil)
 
 		config := tfsdk.Config{
 			Raw:    configValue,
@@ -0,0 +1,81 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceAPIKey_basic(t *testing.T) {
+	resourceName := "pocketid_api_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	expiresAt := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+	renewedExpiresAt := time.Now().Add(60 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceAPIKeyConfig_basic(rName, expiresAt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "expires_at", expiresAt),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					testAccCheckAPIKeyExists(resourceName),
+				),
+			},
+			// ImportState testing. The key is only returned on creation.
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "expires_at", "created_at"},
+			},
+			// Changing expires_at replaces the key.
+			{
+				Config: testAccResourceAPIKeyConfig_basic(rName, renewedExpiresAt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expires_at", renewedExpiresAt),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAPIKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API key ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceAPIKeyConfig_basic(name, expiresAt string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_api_key" "test" {
+  name        = %[1]q
+  description = "Managed by Terraform"
+  expires_at  = %[2]q
+}
+`, name, expiresAt)
+}
@@ -0,0 +1,46 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceApplicationImages_basic(t *testing.T) {
+	resourceName := "pocketid_application_images.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceApplicationImagesConfig(testAccLogoSVG),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "application-images"),
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccLogoSVG)),
+					resource.TestCheckNoResourceAttr(resourceName, "favicon_sha256"),
+				),
+			},
+			// Changing the image uploads it again
+			{
+				Config: testAccResourceApplicationImagesConfig(testAccDarkLogoSVG),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccDarkLogoSVG)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationImagesConfig(logo string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_application_images" "test" {
+  logo_base64 = base64encode(%[1]q)
+}
+`, logo)
+}
@@ -0,0 +1,89 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceClientAllowedGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two groups are allowed on the same client concurrently.
+			{
+				Config: testAccResourceClientAllowedGroupConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pocketid_client_allowed_group.a", "client_id", "pocketid_client.test", "id"),
+					resource.TestCheckResourceAttrPair("pocketid_client_allowed_group.a", "group_id", "pocketid_group.a", "id"),
+					resource.TestCheckResourceAttrPair("pocketid_client_allowed_group.b", "group_id", "pocketid_group.b", "id"),
+					resource.TestCheckResourceAttr("data.pocketid_client.test", "allowed_user_groups.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pocketid_client_allowed_group.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing one group leaves the other in place.
+			{
+				Config: testAccResourceClientAllowedGroupConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pocketid_client.test", "allowed_user_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.pocketid_client.test", "allowed_user_groups.*", "pocketid_group.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceClientAllowedGroupConfig(name string, withB bool) string {
+	config := testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_client" "test" {
+  name          = %[1]q
+  callback_urls = ["https://%[1]s.example.com/callback"]
+}
+
+resource "pocketid_group" "a" {
+  name          = "%[1]s-a"
+  friendly_name = "%[1]s A"
+}
+
+resource "pocketid_group" "b" {
+  name          = "%[1]s-b"
+  friendly_name = "%[1]s B"
+}
+
+resource "pocketid_client_allowed_group" "a" {
+  client_id = pocketid_client.test.id
+  group_id  = pocketid_group.a.id
+}
+`, name)
+
+	dependsOn := "pocketid_client_allowed_group.a"
+	if withB {
+		config += `
+resource "pocketid_client_allowed_group" "b" {
+  client_id = pocketid_client.test.id
+  group_id  = pocketid_group.b.id
+}
+`
+		dependsOn += ", pocketid_client_allowed_group.b"
+	}
+
+	return config + fmt.Sprintf(`
+data "pocketid_client" "test" {
+  id         = pocketid_client.test.id
+  depends_on = [%s]
+}
+`, dependsOn)
+}
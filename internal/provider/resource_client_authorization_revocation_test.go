@@ -0,0 +1,65 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceClientAuthorizationRevocation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "pocketid_client_authorization_revocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Signing in requires a passkey, so a new user has not authorized
+			// the client and the revocation has nothing to revoke.
+			{
+				Config: testAccResourceClientAuthorizationRevocationConfig(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "pocketid_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "was_authorized", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_at"),
+				),
+			},
+			// Changing the triggers revokes the authorization again.
+			{
+				Config: testAccResourceClientAuthorizationRevocationConfig(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.incident", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceClientAuthorizationRevocationConfig(name, incident string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_client" "test" {
+  name          = %[1]q
+  callback_urls = ["https://%[1]s.example.com/callback"]
+}
+
+resource "pocketid_client_authorization_revocation" "test" {
+  user_id   = pocketid_user.test.id
+  client_id = pocketid_client.test.id
+
+  triggers = {
+    incident = %[2]q
+  }
+}
+`, name, incident)
+}
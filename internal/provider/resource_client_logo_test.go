@@ -0,0 +1,89 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testAccLogoSVG     = `<svg xmlns="http://www.w3.org/2000/svg" width="1" height="1"></svg>`
+	testAccDarkLogoSVG = `<svg xmlns="http://www.w3.org/2000/svg" width="2" height="2"></svg>`
+)
+
+func TestAccResourceClientLogo_basic(t *testing.T) {
+	resourceName := "pocketid_client_logo.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceClientLogoConfig_light(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "pocketid_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccLogoSVG)),
+					resource.TestCheckNoResourceAttr(resourceName, "dark_logo_sha256"),
+				),
+			},
+			// Add a dark logo
+			{
+				Config: testAccResourceClientLogoConfig_lightAndDark(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccLogoSVG)),
+					resource.TestCheckResourceAttr(resourceName, "dark_logo_sha256", testAccSHA256(testAccDarkLogoSVG)),
+				),
+			},
+			// ImportState testing. The image source is not stored by Pocket-ID.
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"logo_base64", "dark_logo_base64"},
+			},
+		},
+	})
+}
+
+func testAccSHA256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func testAccResourceClientLogoConfig_light(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_client" "test" {
+  name          = %[1]q
+  callback_urls = ["https://example.com/callback"]
+}
+
+resource "pocketid_client_logo" "test" {
+  client_id   = pocketid_client.test.id
+  logo_base64 = base64encode(%[2]q)
+}
+`, name, testAccLogoSVG)
+}
+
+func testAccResourceClientLogoConfig_lightAndDark(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_client" "test" {
+  name          = %[1]q
+  callback_urls = ["https://example.com/callback"]
+}
+
+resource "pocketid_client_logo" "test" {
+  client_id        = pocketid_client.test.id
+  logo_base64      = base64encode(%[2]q)
+  dark_logo_base64 = base64encode(%[3]q)
+}
+`, name, testAccLogoSVG, testAccDarkLogoSVG)
+}
@@ -0,0 +1,67 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceGroupCustomClaim_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "pocketid_group_custom_claim.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupCustomClaimConfig(rName, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "pocketid_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", "role"),
+					resource.TestCheckResourceAttr(resourceName, "value", "admin"),
+					resource.TestCheckResourceAttr("pocketid_group_custom_claim.team", "value", "platform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceGroupCustomClaimConfig(rName, "viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "viewer"),
+					resource.TestCheckResourceAttr("pocketid_group_custom_claim.team", "value", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGroupCustomClaimConfig(name, role string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_group" "test" {
+  name          = %[1]q
+  friendly_name = "%[1]s Group"
+}
+
+resource "pocketid_group_custom_claim" "role" {
+  group_id = pocketid_group.test.id
+  key      = "role"
+  value    = %[2]q
+}
+
+resource "pocketid_group_custom_claim" "team" {
+  group_id = pocketid_group.test.id
+  key      = "team"
+  value    = "platform"
+}
+`, name, role)
+}
@@ -6,6 +6,7 @@
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -223,6 +224,49 @@ func TestAccResourceGroup_customClaims(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_members(t *testing.T) {
+	resourceName := "pocketid_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := rName + "-members"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with members
+			{
+				Config: testAccResourceGroupConfig_members(groupName, "pocketid_user.first.id", "pocketid_user.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*", "pocketid_user.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*", "pocketid_user.second", "id"),
+				),
+			},
+			// ImportState testing. Imported groups manage their members.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove a member
+			{
+				Config: testAccResourceGroupConfig_members(groupName, "pocketid_user.first.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*", "pocketid_user.first", "id"),
+				),
+			},
+			// Remove all members
+			{
+				Config: testAccResourceGroupConfig_members(groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // Helper function to check if group exists
 func testAccCheckGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -297,6 +341,26 @@ func testAccResourceGroupConfig_customClaims(name, friendlyName string, claims m
 `, name, friendlyName, claimLines)
 }
 
+func testAccResourceGroupConfig_members(name string, members ...string) string {
+	return fmt.Sprintf(`
+resource "pocketid_user" "first" {
+  username = "%[1]s-first"
+  email    = "%[1]s-first@example.com"
+}
+
+resource "pocketid_user" "second" {
+  username = "%[1]s-second"
+  email    = "%[1]s-second@example.com"
+}
+
+resource "pocketid_group" "test" {
+  name          = %[1]q
+  friendly_name = "Members Test Group"
+  members       = [%[2]s]
+}
+`, name, strings.Join(members, ", "))
+}
+
 func testAccResourceGroupConfig_multipleWithPrefix(rName string) string {
 	return fmt.Sprintf(`
 resource "pocketid_group" "developers" {
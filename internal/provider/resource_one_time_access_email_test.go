@@ -0,0 +1,41 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// SMTP is not configured in the test environment, so sending the email fails
+// before it reaches the server. This verifies the resource is wired up and
+// reports the disabled feature. A successful send can only be tested against
+// a live SMTP server.
+func TestAccResourceOneTimeAccessEmail_errorsWhenEmailDisabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_one_time_access_email" "test" {
+  user_id = pocketid_user.test.id
+}
+`, rName),
+				ExpectError: regexp.MustCompile("One-Time Access Emails Disabled"),
+			},
+		},
+	})
+}
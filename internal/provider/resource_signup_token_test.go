@@ -0,0 +1,69 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceSignupToken_basic(t *testing.T) {
+	resourceName := "pocketid_signup_token.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceSignupTokenConfig(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "usage_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "usage_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_groups.0", "pocketid_group.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "signup_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+			// ImportState testing. The ttl is not reported by the server.
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ttl", "token", "signup_url", "created_at"},
+			},
+			// Changing usage_limit replaces the token.
+			{
+				Config: testAccResourceSignupTokenConfig(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "usage_limit", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSignupTokenConfig(name string, usageLimit int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_group" "test" {
+  name          = %[1]q
+  friendly_name = %[1]q
+}
+
+resource "pocketid_signup_token" "test" {
+  ttl         = "24h"
+  usage_limit = %[2]d
+  user_groups = [pocketid_group.test.id]
+}
+`, name, usageLimit)
+}
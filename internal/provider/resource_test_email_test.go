@@ -0,0 +1,29 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// SMTP is not configured in the test environment, so sending the test email
+// fails. This verifies the resource is wired up and surfaces the API error. A
+// successful send can only be tested against a live SMTP server.
+func TestAccResourceTestEmail_errorsWhenSmtpNotConfigured(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pocketid_test_email" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Error sending test email"),
+			},
+		},
+	})
+}
@@ -0,0 +1,71 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUserCustomClaim_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "pocketid_user_custom_claim.department"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two claims for the same user are set concurrently.
+			{
+				Config: testAccResourceUserCustomClaimConfig(rName, "engineering"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", "department"),
+					resource.TestCheckResourceAttr(resourceName, "value", "engineering"),
+					resource.TestCheckResourceAttr("pocketid_user_custom_claim.level", "value", "senior"),
+					resource.TestCheckNoResourceAttr("pocketid_user.test", "custom_claims.%"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing one claim keeps the other, which would otherwise show
+			// up as a non-empty plan.
+			{
+				Config: testAccResourceUserCustomClaimConfig(rName, "platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "platform"),
+					resource.TestCheckResourceAttr("pocketid_user_custom_claim.level", "value", "senior"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserCustomClaimConfig(name, department string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_user_custom_claim" "department" {
+  user_id = pocketid_user.test.id
+  key     = "department"
+  value   = %[2]q
+}
+
+resource "pocketid_user_custom_claim" "level" {
+  user_id = pocketid_user.test.id
+  key     = "level"
+  value   = "senior"
+}
+`, name, department)
+}
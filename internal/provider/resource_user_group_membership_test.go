@@ -0,0 +1,89 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUserGroupMembership_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two memberships for the same user are created concurrently.
+			{
+				Config: testAccResourceUserGroupMembershipConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pocketid_user_group_membership.a", "user_id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttrPair("pocketid_user_group_membership.a", "group_id", "pocketid_group.a", "id"),
+					resource.TestCheckResourceAttrPair("pocketid_user_group_membership.b", "group_id", "pocketid_group.b", "id"),
+					resource.TestCheckResourceAttr("data.pocketid_user.test", "groups.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pocketid_user_group_membership.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing one membership leaves the other in place.
+			{
+				Config: testAccResourceUserGroupMembershipConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pocketid_user.test", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.pocketid_user.test", "groups.*", "pocketid_group.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserGroupMembershipConfig(name string, withB bool) string {
+	config := testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_group" "a" {
+  name          = "%[1]s-a"
+  friendly_name = "%[1]s A"
+}
+
+resource "pocketid_group" "b" {
+  name          = "%[1]s-b"
+  friendly_name = "%[1]s B"
+}
+
+resource "pocketid_user_group_membership" "a" {
+  user_id  = pocketid_user.test.id
+  group_id = pocketid_group.a.id
+}
+`, name)
+
+	dependsOn := "pocketid_user_group_membership.a"
+	if withB {
+		config += `
+resource "pocketid_user_group_membership" "b" {
+  user_id  = pocketid_user.test.id
+  group_id = pocketid_group.b.id
+}
+`
+		dependsOn += ", pocketid_user_group_membership.b"
+	}
+
+	return config + fmt.Sprintf(`
+data "pocketid_user" "test" {
+  id         = pocketid_user.test.id
+  depends_on = [%s]
+}
+`, dependsOn)
+}
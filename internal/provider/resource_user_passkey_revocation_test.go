@@ -0,0 +1,59 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUserPasskeyRevocation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "pocketid_user_passkey_revocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Passkeys cannot be registered through the API, so revoking all
+			// passkeys of a new user revokes none.
+			{
+				Config: testAccResourceUserPasskeyRevocationConfig(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "revoked_passkey_ids.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "revoked_at"),
+				),
+			},
+			// Changing the triggers revokes the passkeys again.
+			{
+				Config: testAccResourceUserPasskeyRevocationConfig(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, "revoked_passkey_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceUserPasskeyRevocationConfig(name, run string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_user_passkey_revocation" "test" {
+  user_id = pocketid_user.test.id
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, name, run)
+}
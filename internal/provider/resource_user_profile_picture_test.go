@@ -0,0 +1,61 @@
+//go:build acc
+// +build acc
+
+package provider_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccProfilePicturePNG is a 1x1 transparent PNG.
+const testAccProfilePicturePNG = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+func TestAccResourceUserProfilePicture_basic(t *testing.T) {
+	resourceName := "pocketid_user_profile_picture.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	picture, err := base64.StdEncoding.DecodeString(testAccProfilePicturePNG)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceUserProfilePictureConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "pocketid_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "profile_picture_sha256", testAccSHA256(string(picture))),
+					resource.TestCheckResourceAttrSet(resourceName, "served_sha256"),
+				),
+			},
+			// A second plan must be empty even though Pocket-ID converts the picture
+			{
+				Config:   testAccResourceUserProfilePictureConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUserProfilePictureConfig(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pocketid_user" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+}
+
+resource "pocketid_user_profile_picture" "test" {
+  user_id                = pocketid_user.test.id
+  profile_picture_base64 = %[2]q
+}
+`, name, testAccProfilePicturePNG)
+}
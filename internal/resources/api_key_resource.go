@@ -0,0 +1,295 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client *client.Client
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Key         types.String `tfsdk:"key"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API key in Pocket-ID.",
+		MarkdownDescription: "Manages an API key in Pocket-ID. " +
+			"The key is returned only once on creation, so it is stored in Terraform state as a sensitive value. " +
+			"Pocket-ID cannot update API keys: changing any argument revokes the key and creates a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API key. Changing this forces a new key to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 50),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the API key. Changing this forces a new key to be created.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The expiration time of the API key in RFC3339 format, e.g. 2030-01-01T00:00:00Z. " +
+					"Changing this forces a new key to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The API key. Returned only on creation; imported keys have no value.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the API key was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &client.APIKeyCreateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		ExpiresAt:   plan.ExpiresAt.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Creating API key", map[string]any{
+		"name":       createReq.Name,
+		"expires_at": createReq.ExpiresAt,
+	})
+
+	apiKeyResp, err := r.client.CreateAPIKey(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapAPIKeyToState(&plan, &apiKeyResp.APIKey)
+	plan.Key = types.StringValue(apiKeyResp.Token)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading API key", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	apiKey, err := r.client.GetAPIKey(ctx, state.ID.ValueString())
+	if err != nil {
+		// The key was revoked outside of Terraform; drop it from state so the
+		// next plan creates a new one.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "API key not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading API key",
+			"Could not read API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapAPIKeyToState(&state, apiKey)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every argument forces replacement.
+func (r *apiKeyResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"API keys cannot be updated. To change an API key, delete and recreate it.",
+	)
+}
+
+// Delete revokes the API key and removes the Terraform state on success.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting API key", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	err := r.client.DeleteAPIKey(ctx, state.ID.ValueString())
+	// An API key that no longer exists has already been revoked.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting API key",
+			"Could not delete API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing API key by ID. The key itself cannot be
+// recovered.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapAPIKeyToState maps an API key onto the resource model. The configured
+// expires_at is kept when it denotes the same instant as the API value, which
+// Pocket-ID may return in a different format.
+func mapAPIKeyToState(model *apiKeyResourceModel, apiKey *client.APIKey) {
+	model.ID = types.StringValue(apiKey.ID)
+	model.Name = types.StringValue(apiKey.Name)
+	model.Description = optionalString(apiKey.Description)
+	model.CreatedAt = types.StringValue(apiKey.CreatedAt)
+
+	if !sameInstant(model.ExpiresAt.ValueString(), apiKey.ExpiresAt) {
+		model.ExpiresAt = types.StringValue(apiKey.ExpiresAt)
+	}
+}
+
+// sameInstant reports whether two RFC3339 timestamps denote the same time.
+func sameInstant(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
+
+// rfc3339Validator validates that a string is an RFC3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be a valid RFC3339 timestamp, e.g. 2030-01-01T00:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The value %q is not a valid RFC3339 timestamp, e.g. 2030-01-01T00:00:00Z: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
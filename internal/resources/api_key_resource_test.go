@@ -0,0 +1,151 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewAPIKeyResource(t *testing.T) {
+	r := resources.NewAPIKeyResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.Resource)(nil), r)
+}
+
+func TestAPIKeyResource_Metadata(t *testing.T) {
+	r := resources.NewAPIKeyResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_api_key", resp.TypeName)
+}
+
+func TestAPIKeyResource_Schema(t *testing.T) {
+	r := resources.NewAPIKeyResource()
+
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.TODO(), req, resp)
+
+	schema := resp.Schema
+	assert.NotNil(t, schema)
+
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["name"].IsRequired())
+	assert.True(t, schema.Attributes["description"].IsOptional())
+	assert.True(t, schema.Attributes["expires_at"].IsRequired())
+	assert.True(t, schema.Attributes["created_at"].IsComputed())
+
+	keyAttr := schema.Attributes["key"]
+	assert.True(t, keyAttr.IsComputed())
+	assert.True(t, keyAttr.IsSensitive())
+}
+
+func TestAPIKeyResource_Configure(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData interface{}
+		expectError  bool
+	}{
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:         "invalid provider data type",
+			providerData: "invalid",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewAPIKeyResource()
+
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.(resource.ResourceWithConfigure).Configure(context.TODO(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestAPIKeyResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	var createReq client.APIKeyCreateRequest
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/api-keys", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createReq))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"apiKey": {
+				"id": "key-123",
+				"name": "ci",
+				"expiresAt": "2030-01-01T00:00:00.000Z",
+				"createdAt": "2026-01-01T00:00:00Z"
+			},
+			"token": "secret-token"
+		}`))
+	})
+
+	r := resources.NewAPIKeyResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":        tftypes.NewValue(tftypes.String, "ci"),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"expires_at":  tftypes.NewValue(tftypes.String, "2030-01-01T00:00:00Z"),
+			"key":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"created_at":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, "ci", createReq.Name)
+	assert.Equal(t, "2030-01-01T00:00:00Z", createReq.ExpiresAt)
+
+	var id, key, expiresAt string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "key-123", id)
+	assert.Equal(t, "secret-token", key)
+	assert.Equal(t, "2030-01-01T00:00:00Z", expiresAt, "the configured format should be kept for the same instant")
+}
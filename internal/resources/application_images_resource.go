@@ -0,0 +1,335 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// applicationImagesID is the fixed identifier used for the singleton
+// application images resource.
+const applicationImagesID = "application-images"
+
+// applicationImages lists the branding images managed by the resource, with
+// the attribute name prefix and the description used for each.
+var applicationImages = []struct {
+	name        string
+	image       client.ApplicationImage
+	description string
+}{
+	{name: "logo", image: client.ApplicationImageLogo, description: "logo shown in light mode"},
+	{name: "dark_logo", image: client.ApplicationImageDarkLogo, description: "logo shown in dark mode"},
+	{name: "favicon", image: client.ApplicationImageFavicon, description: "favicon"},
+	{name: "background_image", image: client.ApplicationImageBackground, description: "background image of the sign-in page"},
+	{name: "email_logo", image: client.ApplicationImageEmailLogo, description: "logo shown in emails"},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &applicationImagesResource{}
+	_ resource.ResourceWithConfigure  = &applicationImagesResource{}
+	_ resource.ResourceWithModifyPlan = &applicationImagesResource{}
+)
+
+// NewApplicationImagesResource is a helper function to simplify the provider implementation.
+func NewApplicationImagesResource() resource.Resource {
+	return &applicationImagesResource{}
+}
+
+// applicationImagesResource is the resource implementation.
+type applicationImagesResource struct {
+	client *client.Client
+}
+
+// applicationImagesResourceModel maps the resource schema data.
+type applicationImagesResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	LogoFile              types.String `tfsdk:"logo_file"`
+	LogoBase64            types.String `tfsdk:"logo_base64"`
+	LogoSHA256            types.String `tfsdk:"logo_sha256"`
+	DarkLogoFile          types.String `tfsdk:"dark_logo_file"`
+	DarkLogoBase64        types.String `tfsdk:"dark_logo_base64"`
+	DarkLogoSHA256        types.String `tfsdk:"dark_logo_sha256"`
+	FaviconFile           types.String `tfsdk:"favicon_file"`
+	FaviconBase64         types.String `tfsdk:"favicon_base64"`
+	FaviconSHA256         types.String `tfsdk:"favicon_sha256"`
+	BackgroundImageFile   types.String `tfsdk:"background_image_file"`
+	BackgroundImageBase64 types.String `tfsdk:"background_image_base64"`
+	BackgroundImageSHA256 types.String `tfsdk:"background_image_sha256"`
+	EmailLogoFile         types.String `tfsdk:"email_logo_file"`
+	EmailLogoBase64       types.String `tfsdk:"email_logo_base64"`
+	EmailLogoSHA256       types.String `tfsdk:"email_logo_sha256"`
+}
+
+// imageFields returns the file, base64 and hash fields of the named image.
+func (m *applicationImagesResourceModel) imageFields(name string) (file, b64, hash *types.String) {
+	switch name {
+	case "logo":
+		return &m.LogoFile, &m.LogoBase64, &m.LogoSHA256
+	case "dark_logo":
+		return &m.DarkLogoFile, &m.DarkLogoBase64, &m.DarkLogoSHA256
+	case "favicon":
+		return &m.FaviconFile, &m.FaviconBase64, &m.FaviconSHA256
+	case "background_image":
+		return &m.BackgroundImageFile, &m.BackgroundImageBase64, &m.BackgroundImageSHA256
+	default:
+		return &m.EmailLogoFile, &m.EmailLogoBase64, &m.EmailLogoSHA256
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *applicationImagesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_images"
+}
+
+// Schema defines the schema for the resource.
+func (r *applicationImagesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Fixed identifier of the application images singleton.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	for _, img := range applicationImages {
+		attributes[img.name+"_file"] = schema.StringAttribute{
+			Description: fmt.Sprintf("Path to the %s. The file extension determines the image type. Conflicts with %s_base64.",
+				img.description, img.name),
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(img.name + "_base64")),
+			},
+		}
+		attributes[img.name+"_base64"] = schema.StringAttribute{
+			Description: fmt.Sprintf("Base64-encoded %s, e.g. from filebase64(). Conflicts with %s_file.", img.description, img.name),
+			Optional:    true,
+		}
+		attributes[img.name+"_sha256"] = schema.StringAttribute{
+			Description: fmt.Sprintf("SHA-256 hash of the %s. Null when the image is not managed.", img.description),
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the branding images of a Pocket-ID instance.",
+		MarkdownDescription: "Manages the branding images of a Pocket-ID instance: the light and dark logos, the favicon, " +
+			"the sign-in background image and the email logo. This is a singleton resource: only one should exist per instance. " +
+			"Only the configured images are managed. Each is set either from a file or from base64-encoded content, and the " +
+			"SHA-256 hash of the image served by Pocket-ID is compared with the configured image, so an image changed outside " +
+			"of Terraform is uploaded again. Removing an image from the configuration, or destroying the resource, resets it to " +
+			"the Pocket-ID default where the server supports it.",
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *applicationImagesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans the hashes of the configured images, so a changed image
+// file or an image that drifted on the server shows up as an update.
+func (r *applicationImagesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan applicationImagesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, img := range applicationImages {
+		file, b64, hash := plan.imageFields(img.name)
+		*hash = plannedImageHash(img.name, *file, *b64, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// syncImages uploads the images whose content changed and resets the images
+// that are no longer configured. An image that fails keeps its hash from state
+// and the other images are still synced, so the state saved with the error
+// records every image that was changed on the server.
+func (r *applicationImagesResource) syncImages(ctx context.Context, plan, state *applicationImagesResourceModel, diags *diag.Diagnostics) {
+	for _, img := range applicationImages {
+		file, b64, hash := plan.imageFields(img.name)
+		_, _, current := state.imageFields(img.name)
+
+		var err error
+		*hash, err = syncImage(*file, *b64, *current,
+			func(fileName string, content []byte) error {
+				return r.client.UploadApplicationImage(ctx, img.image, fileName, content)
+			},
+			func() error {
+				return r.resetImage(ctx, img.name, img.image, diags)
+			},
+		)
+		if err != nil {
+			*hash = *current
+			diags.AddError(
+				"Error updating application image",
+				"Could not update the "+img.description+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+}
+
+// resetImage restores the Pocket-ID default of an image. A server that cannot
+// reset the image keeps the uploaded one, which is reported as a warning.
+func (r *applicationImagesResource) resetImage(ctx context.Context, name string, image client.ApplicationImage, diags *diag.Diagnostics) error {
+	tflog.Debug(ctx, "Resetting application image", map[string]any{
+		"image": string(image),
+	})
+
+	err := r.client.ResetApplicationImage(ctx, image)
+	if errors.Is(err, client.ErrNotFound) {
+		diags.AddAttributeWarning(
+			path.Root(name+"_sha256"),
+			"Application Image Not Reset",
+			"The Pocket-ID server cannot reset this image to its default, so the last uploaded image remains in use. "+
+				"Replace it in the Pocket-ID admin interface if needed.",
+		)
+		return nil
+	}
+	return err
+}
+
+// Create uploads the configured images and sets the initial Terraform state.
+func (r *applicationImagesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationImagesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Uploading application images")
+
+	// Nothing is managed yet, so every configured image is uploaded. The
+	// state is saved even if an image failed, so the uploaded ones are known.
+	r.syncImages(ctx, &plan, &applicationImagesResourceModel{}, &resp.Diagnostics)
+
+	plan.ID = types.StringValue(applicationImagesID)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the hashes of the managed images from the images served by
+// Pocket-ID.
+func (r *applicationImagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationImagesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading application images")
+
+	for _, img := range applicationImages {
+		_, _, hash := state.imageFields(img.name)
+		// Images that are not configured are left unmanaged.
+		if hash.IsNull() {
+			continue
+		}
+
+		content, err := r.client.GetApplicationImage(ctx, img.image)
+		if err != nil {
+			// A missing image is uploaded again by the next apply.
+			if errors.Is(err, client.ErrNotFound) {
+				*hash = types.StringNull()
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error reading application image",
+				"Could not download the "+img.description+": "+err.Error(),
+			)
+			return
+		}
+		*hash = types.StringValue(contentHash(content))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update uploads the images whose content changed and resets the images that
+// are no longer configured.
+func (r *applicationImagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state applicationImagesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating application images")
+
+	// The state is saved even if an image failed, so the synced ones are known.
+	r.syncImages(ctx, &plan, &state, &resp.Diagnostics)
+
+	plan.ID = types.StringValue(applicationImagesID)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete resets the managed images to the Pocket-ID defaults and removes the
+// Terraform state on success.
+func (r *applicationImagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationImagesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, img := range applicationImages {
+		_, _, hash := state.imageFields(img.name)
+		if hash.IsNull() {
+			continue
+		}
+		if err := r.resetImage(ctx, img.name, img.image, &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError(
+				"Error resetting application image",
+				"Could not reset the "+img.description+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
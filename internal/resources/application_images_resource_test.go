@@ -0,0 +1,227 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewApplicationImagesResource(t *testing.T) {
+	r := resources.NewApplicationImagesResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.Resource)(nil), r)
+}
+
+func TestApplicationImagesResource_Metadata(t *testing.T) {
+	r := resources.NewApplicationImagesResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_application_images", resp.TypeName)
+}
+
+func TestApplicationImagesResource_Schema(t *testing.T) {
+	r := resources.NewApplicationImagesResource()
+
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.TODO(), req, resp)
+
+	schema := resp.Schema
+	assert.NotNil(t, schema)
+	assert.True(t, schema.Attributes["id"].IsComputed())
+
+	for _, name := range []string{"logo", "dark_logo", "favicon", "background_image", "email_logo"} {
+		assert.True(t, schema.Attributes[name+"_file"].IsOptional(), name)
+		assert.True(t, schema.Attributes[name+"_base64"].IsOptional(), name)
+		assert.True(t, schema.Attributes[name+"_sha256"].IsComputed(), name)
+	}
+}
+
+func TestApplicationImagesResource_Configure(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData interface{}
+		expectError  bool
+	}{
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:         "invalid provider data type",
+			providerData: "invalid",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewApplicationImagesResource()
+
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.(resource.ResourceWithConfigure).Configure(context.TODO(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+// applicationImagesValue builds a pocketid_application_images object in which
+// only the given attributes are set.
+func applicationImagesValue(ctx context.Context, t *testing.T, r resource.Resource, attrs map[string]string) (tfsdk.State, tftypes.Value) {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(tftypes.String, nil)
+	}
+	for name, value := range attrs {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tfsdk.State{Schema: schemaResp.Schema}, tftypes.NewValue(objectType, values)
+}
+
+func TestApplicationImagesResource_Create(t *testing.T) {
+	ctx := context.Background()
+	favicon := []byte("\x00\x00\x01\x00icon")
+
+	var requests []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		assert.Equal(t, "image.ico", header.Filename)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewApplicationImagesResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := applicationImagesValue(ctx, t, r, map[string]string{
+		"favicon_base64": base64.StdEncoding.EncodeToString(favicon),
+		"favicon_sha256": sha256Hex(favicon),
+	})
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: value}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"PUT /api/application-images/favicon"}, requests, "only the configured image should be uploaded")
+
+	var id, faviconHash string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("favicon_sha256"), &faviconHash)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "application-images", id)
+	assert.Equal(t, sha256Hex(favicon), faviconHash)
+}
+
+func TestApplicationImagesResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.String())
+		if r.URL.Path == "/api/application-images/logo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewApplicationImagesResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := applicationImagesValue(ctx, t, r, map[string]string{
+		"id":                      "application-images",
+		"logo_sha256":             "abc",
+		"background_image_sha256": "def",
+	})
+	state.Raw = value
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		"DELETE /api/application-images/logo?light=true",
+		"DELETE /api/application-images/background",
+	}, requests, "only the managed images should be reset")
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount(), "a server that cannot reset an image should be reported")
+	assert.Equal(t, "Application Image Not Reset", resp.Diagnostics.Warnings()[0].Summary())
+}
+
+// An image that fails to upload must not hide the images uploaded around it,
+// so their hashes are saved with the error.
+func TestApplicationImagesResource_Create_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	logo := []byte("\x89PNG\r\n\x1a\nlogo")
+	favicon := []byte("\x00\x00\x01\x00icon")
+	emailLogo := []byte("\x89PNG\r\n\x1a\nemail")
+
+	var requests []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/api/application-images/favicon" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewApplicationImagesResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := applicationImagesValue(ctx, t, r, map[string]string{
+		"logo_base64":       base64.StdEncoding.EncodeToString(logo),
+		"logo_sha256":       sha256Hex(logo),
+		"favicon_base64":    base64.StdEncoding.EncodeToString(favicon),
+		"favicon_sha256":    sha256Hex(favicon),
+		"email_logo_base64": base64.StdEncoding.EncodeToString(emailLogo),
+		"email_logo_sha256": sha256Hex(emailLogo),
+	})
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: value}}, resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "unexpected diagnostics: %v", resp.Diagnostics)
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "favicon")
+
+	assert.Equal(t, []string{
+		"PUT /api/application-images/logo",
+		"PUT /api/application-images/favicon",
+		"PUT /api/application-images/email",
+	}, requests, "the images after the failed one should still be uploaded")
+
+	var logoHash, faviconHash, emailLogoHash types.String
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("logo_sha256"), &logoHash).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("favicon_sha256"), &faviconHash).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("email_logo_sha256"), &emailLogoHash).HasError())
+	assert.Equal(t, sha256Hex(logo), logoHash.ValueString())
+	assert.True(t, faviconHash.IsNull(), "the failed image is not managed yet")
+	assert.Equal(t, sha256Hex(emailLogo), emailLogoHash.ValueString())
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// requireCapability adds a plan-time error on attribute p when the connected
+// Pocket-ID server is known not to support capability, instead of letting the
+// server silently ignore the configured feature. Nothing is reported when the
+// server version could not be detected.
+func requireCapability(c *client.Client, capability client.Capability, p path.Path, feature string, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+	if supported, known := c.Supports(capability); supported || !known {
+		return
+	}
+
+	version, _ := c.ServerVersion()
+	diags.AddAttributeError(
+		p,
+		"Feature Not Supported by Pocket-ID Server",
+		fmt.Sprintf("%s requires Pocket-ID %s or later, but the connected server is %s. "+
+			"Upgrade Pocket-ID or remove this setting.", feature, capability.MinimumVersion(), version),
+	)
+}
@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+// versionedClient returns a client connected to a server reporting the given
+// version. An empty version makes the version endpoint unavailable.
+func versionedClient(t *testing.T, version string) *client.Client {
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if version == "" || r.URL.Path != "/api/version/current" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := fmt.Fprintf(w, `{"currentVersion": %q}`, version); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	})
+
+	_, err := testClient.DetectServerVersion(context.Background())
+	if version != "" {
+		require.NoError(t, err)
+	}
+	return testClient
+}
+
+func TestClientResource_ModifyPlan_PushedAuthorizationRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		version     string
+		requiresPAR bool
+		expectError bool
+	}{
+		{name: "supported", version: "v2.9.0", requiresPAR: true, expectError: false},
+		{name: "unsupported", version: "v2.8.0", requiresPAR: true, expectError: true},
+		{name: "unsupported_but_disabled", version: "v2.8.0", requiresPAR: false, expectError: false},
+		{name: "unknown_version", version: "", requiresPAR: true, expectError: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := resources.NewClientResource()
+			configResp := &resource.ConfigureResponse{}
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: versionedClient(t, tc.version)}, configResp)
+			require.False(t, configResp.Diagnostics.HasError())
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			require.True(t, ok)
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["name"] = tftypes.NewValue(tftypes.String, "Test Client")
+			values["requires_pushed_authorization_requests"] = tftypes.NewValue(tftypes.Bool, tc.requiresPAR)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tc.expectError {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "v2.9.0")
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clientAllowedGroupResource{}
+	_ resource.ResourceWithConfigure   = &clientAllowedGroupResource{}
+	_ resource.ResourceWithImportState = &clientAllowedGroupResource{}
+)
+
+// NewClientAllowedGroupResource is a helper function to simplify the provider implementation.
+func NewClientAllowedGroupResource() resource.Resource {
+	return &clientAllowedGroupResource{}
+}
+
+// clientAllowedGroupResource is the resource implementation.
+type clientAllowedGroupResource struct {
+	client *client.Client
+}
+
+// clientAllowedGroupResourceModel maps the resource schema data.
+type clientAllowedGroupResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ClientID types.String `tfsdk:"client_id"`
+	GroupID  types.String `tfsdk:"group_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *clientAllowedGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_allowed_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *clientAllowedGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Allows a single user group to use an OIDC client in Pocket-ID.",
+		MarkdownDescription: "Allows a single user group to use an OIDC client in Pocket-ID. " +
+			"Unlike the `allowed_user_groups` attribute of `pocketid_client`, this resource is not authoritative: " +
+			"it only adds and removes its own group and leaves the client's other allowed groups untouched, " +
+			"so different configurations can each grant their own groups access to a shared client. " +
+			"Granting a group restricts the client to its allowed groups. The client stays restricted when the last " +
+			"allowed group is removed, so no user outside an allowed group can use it until a group is granted again " +
+			"or the restriction is lifted, e.g. with an empty `allowed_user_groups`. " +
+			"Do not combine it with `allowed_user_groups` on the same client, as that attribute removes groups it does not list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the grant in the form <client_id>/<group_id>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the OIDC client. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the user group allowed to use the client. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clientAllowedGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create allows the group to use the client and sets the initial Terraform state.
+func (r *clientAllowedGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientAllowedGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Allowing user group to use OIDC client", map[string]any{
+		"client_id": plan.ClientID.ValueString(),
+		"group_id":  plan.GroupID.ValueString(),
+	})
+
+	if err := r.client.AddClientAllowedUserGroup(ctx, plan.ClientID.ValueString(), plan.GroupID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OIDC client allowed group",
+			"Could not allow group "+plan.GroupID.ValueString()+" to use client "+plan.ClientID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ClientID.ValueString() + "/" + plan.GroupID.ValueString())
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read checks that the group is still allowed to use the client.
+func (r *clientAllowedGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clientAllowedGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := state.ClientID.ValueString()
+	groupID := state.GroupID.ValueString()
+	tflog.Debug(ctx, "Reading OIDC client allowed group", map[string]any{
+		"client_id": clientID,
+		"group_id":  groupID,
+	})
+
+	oidcClient, err := r.client.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "OIDC client not found, removing allowed group from state", map[string]any{
+				"client_id": clientID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading OIDC client allowed group",
+			"Could not read OIDC client "+clientID+": "+err.Error(),
+		)
+		return
+	}
+
+	isAllowed := false
+	for _, group := range oidcClient.AllowedUserGroups {
+		if group.ID == groupID {
+			isAllowed = true
+			break
+		}
+	}
+	if !isAllowed {
+		tflog.Warn(ctx, "User group is no longer allowed to use the OIDC client, removing from state", map[string]any{
+			"client_id": clientID,
+			"group_id":  groupID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(clientID + "/" + groupID)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every argument forces replacement.
+func (r *clientAllowedGroupResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"OIDC client allowed groups cannot be updated. To change an allowed group, delete and recreate it.",
+	)
+}
+
+// Delete stops the group from using the client and removes the Terraform state on success.
+func (r *clientAllowedGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clientAllowedGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing user group from OIDC client", map[string]any{
+		"client_id": state.ClientID.ValueString(),
+		"group_id":  state.GroupID.ValueString(),
+	})
+
+	err := r.client.RemoveClientAllowedUserGroup(ctx, state.ClientID.ValueString(), state.GroupID.ValueString())
+	// A client that no longer exists has no allowed groups.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting OIDC client allowed group",
+			"Could not remove group from client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing grant by an ID in the form
+// <client_id>/<group_id>.
+func (r *clientAllowedGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clientID, groupID, ok := strings.Cut(req.ID, "/")
+	if !ok || clientID == "" || groupID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form <client_id>/<group_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+}
@@ -0,0 +1,268 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewClientAllowedGroupResource(t *testing.T) {
+	r := resources.NewClientAllowedGroupResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithImportState)(nil), r)
+}
+
+func TestClientAllowedGroupResource_Metadata(t *testing.T) {
+	r := resources.NewClientAllowedGroupResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_client_allowed_group", resp.TypeName)
+}
+
+func TestClientAllowedGroupResource_Schema(t *testing.T) {
+	r := resources.NewClientAllowedGroupResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["client_id"].IsRequired())
+	assert.True(t, schema.Attributes["group_id"].IsRequired())
+}
+
+// allowedGroupState builds a state or plan value for the allowed group resource.
+func allowedGroupState(t *testing.T, r resource.Resource, id any, clientID, groupID string) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, id),
+		"client_id": tftypes.NewValue(tftypes.String, clientID),
+		"group_id":  tftypes.NewValue(tftypes.String, groupID),
+	})
+
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: raw}, tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+}
+
+// clientWithGroups is a mock OIDC client that records the allowed groups and
+// group restriction written back.
+type clientWithGroups struct {
+	restricted bool
+	groupIDs   []string
+	written    []string
+}
+
+func (s *clientWithGroups) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/oidc/clients/client-123":
+			oidcClient := client.OIDCClient{ID: "client-123", Name: "app", IsGroupRestricted: s.restricted}
+			for _, id := range s.groupIDs {
+				oidcClient.AllowedUserGroups = append(oidcClient.AllowedUserGroups, client.UserGroup{ID: id})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(oidcClient)
+		case r.Method == "PUT" && r.URL.Path == "/api/oidc/clients/client-123/allowed-user-groups":
+			var req client.UpdateAllowedUserGroupsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			s.groupIDs, s.written = req.UserGroupIDs, req.UserGroupIDs
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "PUT" && r.URL.Path == "/api/oidc/clients/client-123":
+			var req client.OIDCClientCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			s.restricted = req.IsGroupRestricted
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(client.OIDCClient{ID: "client-123", Name: req.Name, IsGroupRestricted: req.IsGroupRestricted})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestClientAllowedGroupResource_Create_KeepsOtherGroups(t *testing.T) {
+	ctx := context.Background()
+
+	server := &clientWithGroups{restricted: true, groupIDs: []string{"group-other"}}
+	testClient := createMockServer(t, server.handler(t))
+
+	r := resources.NewClientAllowedGroupResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, plan := allowedGroupState(t, r, tftypes.UnknownValue, "client-123", "group-123")
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"group-other", "group-123"}, server.written)
+	assert.True(t, server.restricted)
+
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	assert.Equal(t, "client-123/group-123", id)
+}
+
+func TestClientAllowedGroupResource_Create_RestrictsClient(t *testing.T) {
+	ctx := context.Background()
+
+	server := &clientWithGroups{}
+	testClient := createMockServer(t, server.handler(t))
+
+	r := resources.NewClientAllowedGroupResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, plan := allowedGroupState(t, r, tftypes.UnknownValue, "client-123", "group-123")
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"group-123"}, server.written)
+	assert.True(t, server.restricted, "a client with allowed groups must be group restricted")
+}
+
+func TestClientAllowedGroupResource_Read(t *testing.T) {
+	tests := []struct {
+		name       string
+		groupIDs   []string
+		wantRemove bool
+	}{
+		{name: "allowed", groupIDs: []string{"group-other", "group-123"}},
+		{name: "removed outside of Terraform", groupIDs: []string{"group-other"}, wantRemove: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := &clientWithGroups{restricted: true, groupIDs: tt.groupIDs}
+			testClient := createMockServer(t, server.handler(t))
+
+			r := resources.NewClientAllowedGroupResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			state, _ := allowedGroupState(t, r, "client-123/group-123", "client-123", "group-123")
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantRemove, resp.State.Raw.IsNull())
+			assert.Nil(t, server.written, "Read must not change allowed groups")
+		})
+	}
+}
+
+func TestClientAllowedGroupResource_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		groupIDs       []string
+		wantWritten    []string
+		wantRestricted bool
+	}{
+		{name: "keeps other groups", groupIDs: []string{"group-other", "group-123"}, wantWritten: []string{"group-other"}, wantRestricted: true},
+		{name: "last group keeps client restricted", groupIDs: []string{"group-123"}, wantWritten: []string{}, wantRestricted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := &clientWithGroups{restricted: true, groupIDs: tt.groupIDs}
+			testClient := createMockServer(t, server.handler(t))
+
+			r := resources.NewClientAllowedGroupResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			state, _ := allowedGroupState(t, r, "client-123/group-123", "client-123", "group-123")
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			assert.Equal(t, tt.wantWritten, server.written)
+			assert.Equal(t, tt.wantRestricted, server.restricted)
+		})
+	}
+}
+
+func TestClientAllowedGroupResource_ImportState(t *testing.T) {
+	tests := []struct {
+		id          string
+		expectError bool
+	}{
+		{id: "client-123/group-123"},
+		{id: "client-123", expectError: true},
+		{id: "client-123/", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			ctx := context.Background()
+			r := resources.NewClientAllowedGroupResource()
+			state, _ := allowedGroupState(t, r, nil, "", "")
+			state.Raw = tftypes.NewValue(state.Raw.Type(), nil)
+
+			resp := &resource.ImportStateResponse{State: state}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				return
+			}
+
+			var clientID, groupID string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("client_id"), &clientID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("group_id"), &groupID)...)
+			assert.Equal(t, "client-123", clientID)
+			assert.Equal(t, "group-123", groupID)
+		})
+	}
+}
+
+// Updating a pocketid_client without an allowed_user_groups attribute must
+// neither change its allowed groups nor lift the restriction they impose.
+func TestClientResource_Update_UnmanagedAllowedGroups(t *testing.T) {
+	ctx := context.Background()
+
+	server := &clientWithGroups{restricted: true, groupIDs: []string{"group-123"}}
+	testClient := createMockServer(t, server.handler(t))
+
+	r := resources.NewClientResource()
+	plan := configuredResource(t, r, testClient)
+	raw := objectValue(t, plan, map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, "client-123"),
+		"name":          tftypes.NewValue(tftypes.String, "app"),
+		"callback_urls": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "https://app.example.com/callback")}),
+		"is_public":     tftypes.NewValue(tftypes.Bool, false),
+		"pkce_enabled":  tftypes.NewValue(tftypes.Bool, false),
+	})
+	plan.Raw = raw
+	state := tfsdk.State{Schema: plan.Schema, Raw: raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.True(t, server.restricted)
+	assert.Nil(t, server.written)
+}
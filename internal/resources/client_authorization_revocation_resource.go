@@ -0,0 +1,218 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &clientAuthorizationRevocationResource{}
+	_ resource.ResourceWithConfigure = &clientAuthorizationRevocationResource{}
+)
+
+// NewClientAuthorizationRevocationResource is a helper function to simplify the provider implementation.
+func NewClientAuthorizationRevocationResource() resource.Resource {
+	return &clientAuthorizationRevocationResource{}
+}
+
+// clientAuthorizationRevocationResource defines the resource implementation.
+type clientAuthorizationRevocationResource struct {
+	client *client.Client
+}
+
+// clientAuthorizationRevocationResourceModel maps the resource schema data.
+type clientAuthorizationRevocationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserID        types.String `tfsdk:"user_id"`
+	ClientID      types.String `tfsdk:"client_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	WasAuthorized types.Bool   `tfsdk:"was_authorized"`
+	RevokedAt     types.String `tfsdk:"revoked_at"`
+}
+
+func (r *clientAuthorizationRevocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_authorization_revocation"
+}
+
+func (r *clientAuthorizationRevocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Revokes the authorization a user granted to an OIDC client in Pocket-ID, so the client has " +
+			"to ask for consent again on the user's next sign-in. This is an action resource: applying it revokes the " +
+			"authorization, and changing any argument revokes it again (the resource is recreated). A revoked " +
+			"authorization cannot be restored, so destroying the resource only removes it from the state. Use the " +
+			"`pocketid_user_authorized_clients` data source to see which clients a user has authorized. Pocket-ID cannot list " +
+			"or revoke the authorizations of all users of a client at once, so revoke them per user, e.g. with `for_each` " +
+			"over the users.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the revocation in the form `<user_id>/<client_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user whose authorization is revoked.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the OIDC client, e.g. `pocketid_client.example.id`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that revokes the authorization again when it changes. If " +
+					"omitted, the authorization is revoked only once (on create).",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"was_authorized": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user had authorized the client when the most recent revocation ran.",
+				Computed:            true,
+			},
+			"revoked_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of the most recent revocation performed by this resource.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *clientAuthorizationRevocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *clientAuthorizationRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientAuthorizationRevocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	clientID := plan.ClientID.ValueString()
+
+	tflog.Debug(ctx, "revoking client authorization", map[string]any{
+		"user_id":   userID,
+		"client_id": clientID,
+	})
+
+	wasAuthorized := true
+	err := r.client.RevokeUserAuthorizedClient(ctx, userID, clientID)
+	if err != nil {
+		if !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error revoking client authorization",
+				fmt.Sprintf("Could not revoke the authorization of user %s for client %s: %s", userID, clientID, err.Error()),
+			)
+			return
+		}
+		// A client the user has not authorized needs no revocation, but
+		// Pocket-ID also answers 404 when the user or the client does not exist.
+		r.checkSubjectsExist(ctx, userID, clientID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		wasAuthorized = false
+	}
+
+	plan.ID = types.StringValue(userID + "/" + clientID)
+	plan.WasAuthorized = types.BoolValue(wasAuthorized)
+	plan.RevokedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clientAuthorizationRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A revocation is an action with no readable server-side state; preserve prior state.
+	var data clientAuthorizationRevocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *clientAuthorizationRevocationResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes force replacement, so Update is never expected.
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"pocketid_client_authorization_revocation cannot be updated in place. Change the triggers map to revoke the authorization again.",
+	)
+}
+
+func (r *clientAuthorizationRevocationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A revoked authorization cannot be restored; there is nothing to delete server-side.
+	tflog.Trace(ctx, "removing pocketid_client_authorization_revocation from state (no server-side action)")
+}
+
+// checkSubjectsExist reports an error unless both the user and the client
+// exist.
+func (r *clientAuthorizationRevocationResource) checkSubjectsExist(ctx context.Context, userID, clientID string, diags *diag.Diagnostics) {
+	if _, err := r.client.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			diags.AddAttributeError(
+				path.Root("user_id"),
+				"User Not Found",
+				fmt.Sprintf("Could not revoke the authorization for client %s: user %s does not exist.", clientID, userID),
+			)
+			return
+		}
+		diags.AddError(
+			"Error revoking client authorization",
+			fmt.Sprintf("Could not check that user %s exists: %s", userID, err.Error()),
+		)
+		return
+	}
+
+	if _, err := r.client.GetClient(ctx, clientID); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			diags.AddAttributeError(
+				path.Root("client_id"),
+				"OIDC Client Not Found",
+				fmt.Sprintf("Could not revoke the authorization of user %s: client %s does not exist.", userID, clientID),
+			)
+			return
+		}
+		diags.AddError(
+			"Error revoking client authorization",
+			fmt.Sprintf("Could not check that client %s exists: %s", clientID, err.Error()),
+		)
+	}
+}
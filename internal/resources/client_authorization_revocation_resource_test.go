@@ -0,0 +1,115 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewClientAuthorizationRevocationResource(t *testing.T) {
+	r := resources.NewClientAuthorizationRevocationResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithConfigure)(nil), r)
+}
+
+func TestClientAuthorizationRevocationResource_Metadata(t *testing.T) {
+	r := resources.NewClientAuthorizationRevocationResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.TODO(), resource.MetadataRequest{ProviderTypeName: "pocketid"}, resp)
+
+	assert.Equal(t, "pocketid_client_authorization_revocation", resp.TypeName)
+}
+
+func TestClientAuthorizationRevocationResource_Schema(t *testing.T) {
+	r := resources.NewClientAuthorizationRevocationResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["client_id"].IsRequired())
+	assert.True(t, schema.Attributes["triggers"].IsOptional())
+	assert.True(t, schema.Attributes["was_authorized"].IsComputed())
+	assert.True(t, schema.Attributes["revoked_at"].IsComputed())
+}
+
+func TestClientAuthorizationRevocationResource_Create(t *testing.T) {
+	tests := []struct {
+		name              string
+		status            int
+		userStatus        int
+		clientStatus      int
+		wantWasAuthorized bool
+		expectError       bool
+	}{
+		{name: "authorized", status: http.StatusNoContent, wantWasAuthorized: true},
+		{name: "not authorized", status: http.StatusNotFound, wantWasAuthorized: false},
+		{name: "server error", status: http.StatusForbidden, expectError: true},
+		{name: "user not found", status: http.StatusNotFound, userStatus: http.StatusNotFound, expectError: true},
+		{name: "client not found", status: http.StatusNotFound, clientStatus: http.StatusNotFound, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "DELETE" && r.URL.Path == "/api/oidc/users/user-123/authorized-clients/client-123":
+					w.WriteHeader(tt.status)
+				case r.Method == "GET" && r.URL.Path == "/api/users/user-123":
+					writeStatusOrJSON(w, tt.userStatus, `{"id": "user-123"}`)
+				case r.Method == "GET" && r.URL.Path == "/api/oidc/clients/client-123":
+					writeStatusOrJSON(w, tt.clientStatus, `{"id": "client-123"}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			r := resources.NewClientAuthorizationRevocationResource()
+			plan := configuredResource(t, r, testClient)
+			plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"user_id":        tftypes.NewValue(tftypes.String, "user-123"),
+				"client_id":      tftypes.NewValue(tftypes.String, "client-123"),
+				"was_authorized": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"revoked_at":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			require.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.expectError {
+				return
+			}
+
+			var id string
+			var wasAuthorized bool
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("was_authorized"), &wasAuthorized).HasError())
+			assert.Equal(t, "user-123/client-123", id)
+			assert.Equal(t, tt.wantWasAuthorized, wasAuthorized)
+		})
+	}
+}
+
+// writeStatusOrJSON writes status, or body as JSON when status is zero.
+func writeStatusOrJSON(w http.ResponseWriter, status int, body string) {
+	if status != 0 {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
@@ -0,0 +1,371 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &clientLogoResource{}
+	_ resource.ResourceWithConfigure        = &clientLogoResource{}
+	_ resource.ResourceWithImportState      = &clientLogoResource{}
+	_ resource.ResourceWithModifyPlan       = &clientLogoResource{}
+	_ resource.ResourceWithConfigValidators = &clientLogoResource{}
+)
+
+// NewClientLogoResource is a helper function to simplify the provider implementation.
+func NewClientLogoResource() resource.Resource {
+	return &clientLogoResource{}
+}
+
+// clientLogoResource is the resource implementation.
+type clientLogoResource struct {
+	client *client.Client
+}
+
+// clientLogoResourceModel maps the resource schema data.
+type clientLogoResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ClientID       types.String `tfsdk:"client_id"`
+	LogoFile       types.String `tfsdk:"logo_file"`
+	LogoBase64     types.String `tfsdk:"logo_base64"`
+	LogoSHA256     types.String `tfsdk:"logo_sha256"`
+	DarkLogoFile   types.String `tfsdk:"dark_logo_file"`
+	DarkLogoBase64 types.String `tfsdk:"dark_logo_base64"`
+	DarkLogoSHA256 types.String `tfsdk:"dark_logo_sha256"`
+}
+
+// Metadata returns the resource type name.
+func (r *clientLogoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_logo"
+}
+
+// Schema defines the schema for the resource.
+func (r *clientLogoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the light and dark logos of an OIDC client in Pocket-ID.",
+		MarkdownDescription: "Manages the light and dark logos of an OIDC client in Pocket-ID. " +
+			"Each logo is set either from a file or from base64-encoded content. " +
+			"The SHA-256 hash of the logo stored in Pocket-ID is compared with the configured image, " +
+			"so a logo changed or removed outside of Terraform is uploaded again. " +
+			"Destroying the resource deletes the logos.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the OIDC client, identical to client_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the OIDC client. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"logo_file": schema.StringAttribute{
+				Description: "Path to the logo shown in light mode. The file extension determines the image type. " +
+					"Conflicts with logo_base64.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("logo_base64")),
+				},
+			},
+			"logo_base64": schema.StringAttribute{
+				Description: "Base64-encoded logo shown in light mode, e.g. from filebase64(). Conflicts with logo_file.",
+				Optional:    true,
+			},
+			"logo_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the light mode logo.",
+				Computed:    true,
+			},
+			"dark_logo_file": schema.StringAttribute{
+				Description: "Path to the logo shown in dark mode. The file extension determines the image type. " +
+					"Conflicts with dark_logo_base64.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("dark_logo_base64")),
+				},
+			},
+			"dark_logo_base64": schema.StringAttribute{
+				Description: "Base64-encoded logo shown in dark mode, e.g. from filebase64(). Conflicts with dark_logo_file.",
+				Optional:    true,
+			},
+			"dark_logo_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the dark mode logo.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires at least one logo to be configured.
+func (r *clientLogoResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("logo_file"),
+			path.MatchRoot("logo_base64"),
+			path.MatchRoot("dark_logo_file"),
+			path.MatchRoot("dark_logo_base64"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clientLogoResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans the hashes of the configured logos, so a changed image file
+// or a logo that drifted on the server shows up as an update.
+func (r *clientLogoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan clientLogoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LogoSHA256 = plannedImageHash("logo", plan.LogoFile, plan.LogoBase64, &resp.Diagnostics)
+	plan.DarkLogoSHA256 = plannedImageHash("dark_logo", plan.DarkLogoFile, plan.DarkLogoBase64, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create uploads the configured logos and sets the initial Terraform state.
+func (r *clientLogoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientLogoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := plan.ClientID.ValueString()
+	tflog.Debug(ctx, "Uploading client logos", map[string]any{
+		"client_id": clientID,
+	})
+
+	var err error
+	plan.LogoSHA256, err = r.syncLogo(ctx, clientID, true, plan.LogoFile, plan.LogoBase64, types.StringNull())
+	if err == nil {
+		plan.DarkLogoSHA256, err = r.syncLogo(ctx, clientID, false, plan.DarkLogoFile, plan.DarkLogoBase64, types.StringNull())
+		// No state is saved when Create fails, so the light logo uploaded
+		// above would be left behind untracked.
+		if err != nil && !plan.LogoSHA256.IsNull() {
+			if cleanupErr := r.deleteLogo(ctx, clientID, true); cleanupErr != nil {
+				tflog.Warn(ctx, "Could not remove the uploaded light logo after the dark logo failed", map[string]any{
+					"client_id": clientID,
+					"error":     cleanupErr.Error(),
+				})
+			}
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading client logo",
+			"Could not upload logo for client "+clientID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ClientID
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the logo hashes from the images stored in Pocket-ID.
+func (r *clientLogoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clientLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := state.ID.ValueString()
+	tflog.Debug(ctx, "Reading client logos", map[string]any{
+		"client_id": clientID,
+	})
+
+	oidcClient, err := r.client.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "Client not found, removing logos from state", map[string]any{
+				"client_id": clientID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading client logo",
+			"Could not read client ID "+clientID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ClientID = types.StringValue(oidcClient.ID)
+	state.LogoSHA256, err = r.logoHash(ctx, clientID, true, oidcClient.HasLogo)
+	if err == nil {
+		state.DarkLogoSHA256, err = r.logoHash(ctx, clientID, false, oidcClient.HasDarkLogo)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading client logo",
+			"Could not download logo for client "+clientID+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update uploads the logos whose content changed and deletes the logos that
+// are no longer configured.
+func (r *clientLogoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state clientLogoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := plan.ClientID.ValueString()
+	tflog.Debug(ctx, "Updating client logos", map[string]any{
+		"client_id": clientID,
+	})
+
+	var err error
+	plan.LogoSHA256, err = r.syncLogo(ctx, clientID, true, plan.LogoFile, plan.LogoBase64, state.LogoSHA256)
+	if err == nil {
+		plan.DarkLogoSHA256, err = r.syncLogo(ctx, clientID, false, plan.DarkLogoFile, plan.DarkLogoBase64, state.DarkLogoSHA256)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating client logo",
+			"Could not update logo for client "+clientID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ClientID
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the client's logos and removes the Terraform state on success.
+func (r *clientLogoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clientLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := state.ID.ValueString()
+	tflog.Debug(ctx, "Deleting client logos", map[string]any{
+		"client_id": clientID,
+	})
+
+	var err error
+	if !state.LogoSHA256.IsNull() {
+		err = r.deleteLogo(ctx, clientID, true)
+	}
+	if err == nil && !state.DarkLogoSHA256.IsNull() {
+		err = r.deleteLogo(ctx, clientID, false)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting client logo",
+			"Could not delete logo for client "+clientID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// deleteLogo deletes the light or dark logo of the client.
+func (r *clientLogoResource) deleteLogo(ctx context.Context, clientID string, light bool) error {
+	err := r.client.DeleteClientLogo(ctx, clientID, light)
+	// A logo or client that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// ImportState imports the logos of an existing client by client ID.
+func (r *clientLogoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncLogo brings the light or dark logo in line with the configuration and
+// returns its new hash.
+func (r *clientLogoResource) syncLogo(ctx context.Context, clientID string, light bool, file, b64, current types.String) (types.String, error) {
+	return syncImage(file, b64, current,
+		func(fileName string, content []byte) error {
+			return r.client.UploadClientLogo(ctx, clientID, light, fileName, content)
+		},
+		func() error {
+			if err := r.client.DeleteClientLogo(ctx, clientID, light); err != nil && !errors.Is(err, client.ErrNotFound) {
+				return err
+			}
+			return nil
+		},
+	)
+}
+
+// logoHash returns the hash of the light or dark logo stored in Pocket-ID, or
+// null when the client has none.
+func (r *clientLogoResource) logoHash(ctx context.Context, clientID string, light, hasLogo bool) (types.String, error) {
+	if !hasLogo {
+		return types.StringNull(), nil
+	}
+
+	content, err := r.client.GetClientLogo(ctx, clientID, light)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return types.StringNull(), nil
+		}
+		return types.StringNull(), err
+	}
+	return types.StringValue(contentHash(content)), nil
+}
@@ -0,0 +1,254 @@
+package resources_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewClientLogoResource(t *testing.T) {
+	r := resources.NewClientLogoResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.Resource)(nil), r)
+}
+
+func TestClientLogoResource_Metadata(t *testing.T) {
+	r := resources.NewClientLogoResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_client_logo", resp.TypeName)
+}
+
+func TestClientLogoResource_Schema(t *testing.T) {
+	r := resources.NewClientLogoResource()
+
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.TODO(), req, resp)
+
+	schema := resp.Schema
+	assert.NotNil(t, schema)
+
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["client_id"].IsRequired())
+	for _, name := range []string{"logo_file", "logo_base64", "dark_logo_file", "dark_logo_base64"} {
+		assert.True(t, schema.Attributes[name].IsOptional(), name)
+	}
+	assert.True(t, schema.Attributes["logo_sha256"].IsComputed())
+	assert.True(t, schema.Attributes["dark_logo_sha256"].IsComputed())
+}
+
+func TestClientLogoResource_Configure(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData interface{}
+		expectError  bool
+	}{
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:         "invalid provider data type",
+			providerData: "invalid",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewClientLogoResource()
+
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.(resource.ResourceWithConfigure).Configure(context.TODO(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+// clientLogoValue builds a pocketid_client_logo object with a light logo set
+// from base64 content and the given hashes.
+func clientLogoValue(ctx context.Context, t *testing.T, r resource.Resource, logo []byte, logoHash, darkHash interface{}) (tfsdk.State, tftypes.Value) {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, "client-123"),
+		"client_id":        tftypes.NewValue(tftypes.String, "client-123"),
+		"logo_file":        tftypes.NewValue(tftypes.String, nil),
+		"logo_base64":      tftypes.NewValue(tftypes.String, base64.StdEncoding.EncodeToString(logo)),
+		"logo_sha256":      tftypes.NewValue(tftypes.String, logoHash),
+		"dark_logo_file":   tftypes.NewValue(tftypes.String, nil),
+		"dark_logo_base64": tftypes.NewValue(tftypes.String, nil),
+		"dark_logo_sha256": tftypes.NewValue(tftypes.String, darkHash),
+	})
+	return tfsdk.State{Schema: schemaResp.Schema}, value
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestClientLogoResource_Create(t *testing.T) {
+	ctx := context.Background()
+	logo := []byte("<svg></svg>")
+
+	var uploaded []byte
+	var uploadedName string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/oidc/clients/client-123/logo", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("light"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		uploaded, err = io.ReadAll(file)
+		require.NoError(t, err)
+		uploadedName = header.Filename
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewClientLogoResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := clientLogoValue(ctx, t, r, logo, sha256Hex(logo), nil)
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: value}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, logo, uploaded)
+	assert.Equal(t, "image.svg", uploadedName)
+
+	var logoHash string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("logo_sha256"), &logoHash)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, sha256Hex(logo), logoHash)
+}
+
+func TestClientLogoResource_Read_DetectsDrift(t *testing.T) {
+	ctx := context.Background()
+	logo := []byte("<svg></svg>")
+	serverLogo := []byte("<svg>changed</svg>")
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/oidc/clients/client-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "client-123", "name": "app", "hasLogo": true, "hasDarkLogo": false}`))
+		case "/api/oidc/clients/client-123/logo":
+			assert.Equal(t, "true", r.URL.Query().Get("light"), "the dark logo must not be fetched when the client has none")
+			_, _ = w.Write(serverLogo)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := resources.NewClientLogoResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := clientLogoValue(ctx, t, r, logo, sha256Hex(logo), sha256Hex([]byte("dark")))
+	state.Raw = value
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var logoHash string
+	var darkLogoHash *string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("logo_sha256"), &logoHash)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("dark_logo_sha256"), &darkLogoHash)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, sha256Hex(serverLogo), logoHash, "the hash of the server-side logo should replace the state")
+	assert.Nil(t, darkLogoHash, "a deleted dark logo should be null")
+}
+
+func TestClientLogoResource_Create_RemovesLightLogoWhenDarkFails(t *testing.T) {
+	ctx := context.Background()
+	logo := []byte("<svg></svg>")
+
+	var requests []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" light="+r.URL.Query().Get("light"))
+		if r.Method == "POST" && r.URL.Query().Get("light") == "false" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewClientLogoResource()
+	plan := configuredResource(t, r, testClient)
+	encoded := base64.StdEncoding.EncodeToString(logo)
+	plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"client_id":        tftypes.NewValue(tftypes.String, "client-123"),
+		"logo_base64":      tftypes.NewValue(tftypes.String, encoded),
+		"logo_sha256":      tftypes.NewValue(tftypes.String, sha256Hex(logo)),
+		"dark_logo_base64": tftypes.NewValue(tftypes.String, encoded),
+		"dark_logo_sha256": tftypes.NewValue(tftypes.String, sha256Hex(logo)),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"POST light=true", "POST light=false", "DELETE light=true"}, requests,
+		"the light logo uploaded before the failure must be removed")
+}
+
+func TestClientLogoResource_Delete_Order(t *testing.T) {
+	ctx := context.Background()
+	logo := []byte("<svg></svg>")
+
+	var requests []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		requests = append(requests, "light="+r.URL.Query().Get("light"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := resources.NewClientLogoResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	// Repeat to catch a random order, e.g. from ranging over a map.
+	for range 10 {
+		requests = nil
+		state, value := clientLogoValue(ctx, t, r, logo, sha256Hex(logo), sha256Hex(logo))
+		state.Raw = value
+		resp := &resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+		assert.Equal(t, []string{"light=true", "light=false"}, requests)
+	}
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -9,6 +10,7 @@
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +30,7 @@
 	_ resource.ResourceWithConfigure      = &clientResource{}
 	_ resource.ResourceWithImportState    = &clientResource{}
 	_ resource.ResourceWithValidateConfig = &clientResource{}
+	_ resource.ResourceWithModifyPlan     = &clientResource{}
 )
 
 // NewClientResource is a helper function to simplify the provider implementation.
@@ -139,7 +142,7 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"requires_pushed_authorization_requests": schema.BoolAttribute{
 				Description: "Whether this client requires Pushed Authorization Requests (PAR, RFC 9126). Defaults to false. " +
 					"Applies to confidential clients only — Pocket-ID coerces this to false for public clients (is_public = true). " +
-					"Enforced only by Pocket-ID versions that support PAR (v2.9.0+); on older versions the value is stored in state but not enforced.",
+					"Requires Pocket-ID v2.9.0 or later; setting it to true against an older server is rejected at plan time.",
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
@@ -180,7 +183,10 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:     booldefault.StaticBool(true),
 			},
 			"allowed_user_groups": schema.ListAttribute{
-				Description: "List of user group IDs that are allowed to use this client. If empty, all users can use this client.",
+				Description: "List of user group IDs that are allowed to use this client. If empty, all users can use this client. " +
+					"Setting this attribute replaces all allowed groups; when it is not set, allowed groups are left unmanaged, " +
+					"e.g. for pocketid_client_allowed_group. Removing the attribute keeps the current allowed groups and restriction; " +
+					"set it to an empty list to allow all users.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
@@ -239,6 +245,25 @@ func (r *clientResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
+// ModifyPlan rejects features the connected Pocket-ID server does not support.
+func (r *clientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var requiresPAR types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("requires_pushed_authorization_requests"), &requiresPAR)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if requiresPAR.ValueBool() {
+		requireCapability(r.client, client.CapabilityPushedAuthorizationRequests,
+			path.Root("requires_pushed_authorization_requests"), "Pushed Authorization Requests", &resp.Diagnostics)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -276,7 +301,7 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 		"isPublic": createReq.IsPublic,
 	})
 
-	clientResp, err := r.client.CreateClient(createReq)
+	clientResp, err := r.client.CreateClient(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating OIDC client",
@@ -290,7 +315,7 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 	})
 
 	// Map API response to Terraform model and preserve fields
-	apiModel := mapAPIClientToModel(ctx, clientResp)
+	apiModel := mapAPIClientToModel(ctx, clientResp, isManaged(plan.AllowedUserGroups))
 	plan.ID = apiModel.ID
 	plan.HasLogo = apiModel.HasLogo
 	plan.RequiresReauthentication = apiModel.RequiresReauthentication
@@ -305,10 +330,10 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Generate client secret for non-public clients
 	if !plan.IsPublic.ValueBool() {
 		tflog.Debug(ctx, "Generating client secret for non-public client")
-		secret, err := r.client.GenerateClientSecret(clientResp.ID)
+		secret, err := r.client.GenerateClientSecret(ctx, clientResp.ID)
 		if err != nil {
 			// Try to clean up the created client
-			_ = r.client.DeleteClient(clientResp.ID)
+			_ = r.client.DeleteClient(ctx, clientResp.ID)
 			resp.Diagnostics.AddError(
 				"Error generating client secret",
 				"Could not generate client secret, the client was deleted. Error: "+err.Error(),
@@ -329,10 +354,10 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 			tflog.Debug(ctx, "Updating allowed user groups", map[string]any{
 				"groups": groupIDs,
 			})
-			err = r.client.UpdateClientAllowedUserGroups(clientResp.ID, groupIDs)
+			err = r.client.UpdateClientAllowedUserGroups(ctx, clientResp.ID, groupIDs)
 			if err != nil {
 				// Try to clean up the created client
-				_ = r.client.DeleteClient(clientResp.ID)
+				_ = r.client.DeleteClient(ctx, clientResp.ID)
 				resp.Diagnostics.AddError(
 					"Error updating allowed user groups",
 					"Could not update allowed user groups, the client was deleted. Error: "+err.Error(),
@@ -362,8 +387,17 @@ func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, res
 	})
 
 	// Get client from API
-	clientResp, err := r.client.GetClient(state.ID.ValueString())
+	clientResp, err := r.client.GetClient(ctx, state.ID.ValueString())
 	if err != nil {
+		// The object was deleted outside of Terraform; drop it from state so
+		// the next plan recreates it.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "OIDC client not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading OIDC client",
 			"Could not read OIDC client ID "+state.ID.ValueString()+": "+err.Error(),
@@ -406,17 +440,15 @@ func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.LogoutCallbackURLs = types.ListNull(types.StringType)
 	}
 
-	// Update allowed user groups
-	if len(clientResp.AllowedUserGroups) > 0 {
-		var groupIDs []string
-		for _, group := range clientResp.AllowedUserGroups {
-			groupIDs = append(groupIDs, group.ID)
-		}
-		allowedGroups, diags := types.ListValueFrom(ctx, types.StringType, groupIDs)
+	// Update allowed user groups while they are managed, see isManaged.
+	if !isManaged(state.AllowedUserGroups) {
+		tflog.Debug(ctx, "Allowed user groups are not managed, skipping refresh", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+	} else {
+		allowedGroups, diags := allowedUserGroupsToState(ctx, clientResp.AllowedUserGroups, isManaged(state.AllowedUserGroups))
 		resp.Diagnostics.Append(diags...)
 		state.AllowedUserGroups = allowedGroups
-	} else {
-		state.AllowedUserGroups = types.ListNull(types.StringType)
 	}
 
 	// Note: client_secret is not updated from Read as it's only available during creation
@@ -457,9 +489,21 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Determine if group restriction is enabled based on allowed_user_groups
+	// Determine if group restriction is enabled based on allowed_user_groups.
+	// While the groups are not managed, the current restriction is kept so
+	// groups granted with pocketid_client_allowed_group stay in effect.
 	var isGroupRestricted bool
-	if !plan.AllowedUserGroups.IsNull() && !plan.AllowedUserGroups.IsUnknown() {
+	if !isManaged(plan.AllowedUserGroups) {
+		current, err := r.client.GetClient(ctx, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating OIDC client",
+				"Could not read OIDC client, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		isGroupRestricted = current.IsGroupRestricted
+	} else if !plan.AllowedUserGroups.IsNull() && !plan.AllowedUserGroups.IsUnknown() {
 		var groupIDs []string
 		_ = plan.AllowedUserGroups.ElementsAs(ctx, &groupIDs, false)
 		isGroupRestricted = len(groupIDs) > 0
@@ -494,7 +538,7 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"name": updateReq.Name,
 	})
 
-	clientResp, err := r.client.UpdateClient(plan.ID.ValueString(), updateReq)
+	clientResp, err := r.client.UpdateClient(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating OIDC client",
@@ -517,7 +561,7 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.LaunchURL = types.StringNull()
 	}
 
-	// Handle allowed user groups
+	// Handle allowed user groups while they are managed, see isManaged.
 	var plannedGroupIDs []string
 	if !plan.AllowedUserGroups.IsNull() && !plan.AllowedUserGroups.IsUnknown() {
 		diags = plan.AllowedUserGroups.ElementsAs(ctx, &plannedGroupIDs, false)
@@ -530,7 +574,7 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.Append(diags...)
 	}
 
-	if !resp.Diagnostics.HasError() {
+	if isManaged(plan.AllowedUserGroups) && !resp.Diagnostics.HasError() {
 		// Check if groups have changed
 		groupsChanged := false
 		if len(plannedGroupIDs) != len(currentGroupIDs) {
@@ -553,7 +597,7 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 			tflog.Debug(ctx, "Updating allowed user groups", map[string]any{
 				"groups": plannedGroupIDs,
 			})
-			err = r.client.UpdateClientAllowedUserGroups(plan.ID.ValueString(), plannedGroupIDs)
+			err = r.client.UpdateClientAllowedUserGroups(ctx, plan.ID.ValueString(), plannedGroupIDs)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error updating allowed user groups",
@@ -587,8 +631,9 @@ func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 
 	// Delete the client
-	err := r.client.DeleteClient(state.ID.ValueString())
-	if err != nil {
+	err := r.client.DeleteClient(ctx, state.ID.ValueString())
+	// An OIDC client that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting OIDC client",
 			"Could not delete OIDC client, unexpected error: "+err.Error(),
@@ -605,6 +650,9 @@ func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *clientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and set it as the resource ID
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// An empty list marks the allowed user groups as managed so Read imports them.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allowed_user_groups"), types.ListValueMust(types.StringType, nil))...)
 }
 
 // urlValidator validates that a string is a valid URL
@@ -753,8 +801,26 @@ func optionalString(value string) types.String {
 	return types.StringValue(value)
 }
 
-// mapAPIClientToModel maps an API OIDCClient response into the Terraform resource model.
-func mapAPIClientToModel(ctx context.Context, api *client.OIDCClient) clientResourceModel {
+// allowedUserGroupsToState converts the allowed user groups of a client into a
+// Terraform list of group IDs. While the attribute is managed, see isManaged,
+// no groups are mapped to an empty list so that allowed_user_groups = [] stays
+// managed; otherwise they are mapped to a null list.
+func allowedUserGroupsToState(ctx context.Context, groups []client.UserGroup, managed bool) (types.List, diag.Diagnostics) {
+	if len(groups) == 0 && !managed {
+		return types.ListNull(types.StringType), diag.Diagnostics{}
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	return types.ListValueFrom(ctx, types.StringType, groupIDs)
+}
+
+// mapAPIClientToModel maps an API OIDCClient response into the Terraform
+// resource model. managedAllowedUserGroups reports whether allowed_user_groups
+// is managed, see allowedUserGroupsToState.
+func mapAPIClientToModel(ctx context.Context, api *client.OIDCClient, managedAllowedUserGroups bool) clientResourceModel {
 	var model clientResourceModel
 	model.ID = types.StringValue(api.ID)
 	model.Name = types.StringValue(api.Name)
@@ -774,16 +840,7 @@ func mapAPIClientToModel(ctx context.Context, api *client.OIDCClient) clientReso
 		model.LogoutCallbackURLs = types.ListNull(types.StringType)
 	}
 
-	if len(api.AllowedUserGroups) > 0 {
-		var groupIDs []string
-		for _, g := range api.AllowedUserGroups {
-			groupIDs = append(groupIDs, g.ID)
-		}
-		allowed, _ := types.ListValueFrom(ctx, types.StringType, groupIDs)
-		model.AllowedUserGroups = allowed
-	} else {
-		model.AllowedUserGroups = types.ListNull(types.StringType)
-	}
+	model.AllowedUserGroups, _ = allowedUserGroupsToState(ctx, api.AllowedUserGroups, managedAllowedUserGroups)
 
 	if api.LaunchURL != "" {
 		model.LaunchURL = types.StringValue(api.LaunchURL)
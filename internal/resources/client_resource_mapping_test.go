@@ -82,7 +82,7 @@ func TestMapAPIClientToModel(t *testing.T) {
 		},
 	}
 
-	model := mapAPIClientToModel(ctx, api)
+	model := mapAPIClientToModel(ctx, api, false)
 
 	assert.Equal(t, "client-1", model.ID.ValueString())
 	assert.Equal(t, "API Client", model.Name.ValueString())
@@ -120,7 +120,23 @@ func TestMapAPIClientToModelNoFederatedIdentities(t *testing.T) {
 		CallbackURLs: []string{"https://example.com/callback"},
 	}
 
-	model := mapAPIClientToModel(ctx, api)
+	model := mapAPIClientToModel(ctx, api, false)
 
 	assert.True(t, model.FederatedIdentities.IsNull())
 }
+
+func TestMapAPIClientToModelNoAllowedUserGroups(t *testing.T) {
+	ctx := context.Background()
+
+	api := &client.OIDCClient{
+		ID:           "client-3",
+		Name:         "All Users",
+		CallbackURLs: []string{"https://example.com/callback"},
+	}
+
+	assert.True(t, mapAPIClientToModel(ctx, api, false).AllowedUserGroups.IsNull())
+
+	managed := mapAPIClientToModel(ctx, api, true).AllowedUserGroups
+	assert.False(t, managed.IsNull())
+	assert.Empty(t, managed.Elements())
+}
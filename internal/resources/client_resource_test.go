@@ -2,11 +2,19 @@
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
 	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
 )
 
@@ -134,3 +142,78 @@ func TestUserResource_Schema(t *testing.T) {
 	assert.True(t, emailVerifiedAttr.IsOptional(), "email_verified should be optional")
 	assert.True(t, emailVerifiedAttr.IsComputed(), "email_verified should be computed")
 }
+
+// State written by earlier versions holds the allowed groups of clients whose
+// configuration never set allowed_user_groups. Removing the attribute must
+// keep the groups and the restriction.
+func TestClientResource_Update_RemovingAllowedGroupsKeepsThem(t *testing.T) {
+	ctx := context.Background()
+
+	var update *client.OIDCClientCreateRequest
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/oidc/clients/client-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "client-123", "name": "app", "isGroupRestricted": true, "allowedUserGroups": [{"id": "group-a"}]}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/oidc/clients/client-123":
+			update = &client.OIDCClientCreateRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(update))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "client-123", "name": "app", "isGroupRestricted": true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewClientResource()
+	plan := configuredResource(t, r, testClient)
+	attrs := map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "client-123"),
+		"name": tftypes.NewValue(tftypes.String, "app"),
+	}
+	plan.Raw = objectValue(t, plan, attrs)
+	attrs["allowed_user_groups"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "group-a"),
+	})
+	state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, attrs)}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	require.NotNil(t, update)
+	assert.True(t, update.IsGroupRestricted)
+}
+
+// An empty allowed_user_groups list allows all users and keeps the attribute
+// managed, so a refresh must keep it an empty list.
+func TestClientResource_Read_EmptyAllowedGroups(t *testing.T) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/oidc/clients/client-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "client-123", "name": "app", "callbackURLs": ["https://app.example.com/callback"], "allowedUserGroups": []}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewClientResource()
+	plan := configuredResource(t, r, testClient)
+	state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "client-123"),
+		"name":                tftypes.NewValue(tftypes.String, "app"),
+		"allowed_user_groups": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+	})}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var groups types.List
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("allowed_user_groups"), &groups).HasError())
+	assert.False(t, groups.IsNull(), "allowed_user_groups must stay managed")
+	assert.Empty(t, groups.Elements())
+}
@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &customClaimResource{}
+	_ resource.ResourceWithConfigure   = &customClaimResource{}
+	_ resource.ResourceWithImportState = &customClaimResource{}
+)
+
+// customClaimSubject describes the Pocket-ID object whose custom claims a
+// customClaimResource manages, such as a user or a user group.
+type customClaimSubject struct {
+	// typeName is appended to the provider type name.
+	typeName string
+	// attribute is the name of the attribute holding the subject's ID.
+	attribute string
+	// name is the subject's name in messages, e.g. "user group".
+	name string
+
+	description         string
+	markdownDescription string
+	// attributeDescription describes the attribute holding the subject's ID.
+	attributeDescription string
+
+	getClaims   func(c *client.Client, ctx context.Context, id string) ([]client.CustomClaim, error)
+	setClaim    func(c *client.Client, ctx context.Context, id, key, value string) error
+	deleteClaim func(c *client.Client, ctx context.Context, id, key string) error
+}
+
+// customClaimResource manages a single custom claim of a subject and leaves
+// the subject's other claims untouched.
+type customClaimResource struct {
+	client  *client.Client
+	subject customClaimSubject
+}
+
+// customClaimResourceModel maps the resource schema data. SubjectID is stored
+// in the attribute named by the subject, so the model is read and written
+// attribute by attribute rather than with struct tags.
+type customClaimResourceModel struct {
+	ID        types.String
+	SubjectID types.String
+	Key       types.String
+	Value     types.String
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// Metadata returns the resource type name.
+func (r *customClaimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.subject.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *customClaimResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         r.subject.description,
+		MarkdownDescription: r.subject.markdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("The ID of the claim in the form <%s>/<key>.", r.subject.attribute),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.subject.attribute: schema.StringAttribute{
+				Description: r.subject.attributeDescription,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description:         "The name of the claim. Reserved claim names (e.g. 'email', 'groups', 'sub') are rejected by Pocket-ID. Changing this forces a new resource to be created.",
+				MarkdownDescription: "The name of the claim. Reserved claim names (e.g. `email`, `groups`, `sub`) are rejected by Pocket-ID. Changing this forces a new resource to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the claim.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customClaimResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create sets the claim and sets the initial Terraform state.
+func (r *customClaimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, diags := r.get(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setClaim(ctx, plan, "creating", &resp.Diagnostics) {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.SubjectID.ValueString() + "/" + plan.Key.ValueString())
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, plan)...)
+}
+
+// Read refreshes the value of the claim.
+func (r *customClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, diags := r.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subjectID := state.SubjectID.ValueString()
+	key := state.Key.ValueString()
+	tflog.Debug(ctx, "Reading "+r.subject.name+" custom claim", map[string]any{
+		r.subject.attribute: subjectID,
+		"key":               key,
+	})
+
+	claims, err := r.subject.getClaims(r.client, ctx, subjectID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "Custom claim subject not found, removing custom claim from state", map[string]any{
+				r.subject.attribute: subjectID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading "+r.subject.name+" custom claim",
+			"Could not read "+r.subject.name+" "+subjectID+": "+err.Error(),
+		)
+		return
+	}
+
+	value, ok := findCustomClaim(claims, key)
+	if !ok {
+		tflog.Warn(ctx, "Custom claim no longer exists, removing from state", map[string]any{
+			r.subject.attribute: subjectID,
+			"key":               key,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(subjectID + "/" + key)
+	state.Value = types.StringValue(value)
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, state)...)
+}
+
+// Update sets the new value of the claim.
+func (r *customClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, diags := r.get(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setClaim(ctx, plan, "updating", &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, plan)...)
+}
+
+// Delete removes the claim and removes the Terraform state on success.
+func (r *customClaimResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state, diags := r.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing "+r.subject.name+" custom claim", map[string]any{
+		r.subject.attribute: state.SubjectID.ValueString(),
+		"key":               state.Key.ValueString(),
+	})
+
+	err := r.subject.deleteClaim(r.client, ctx, state.SubjectID.ValueString(), state.Key.ValueString())
+	// A subject that no longer exists has no claims.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting "+r.subject.name+" custom claim",
+			"Could not remove claim from "+r.subject.name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing claim by an ID in the form <subject_id>/<key>.
+func (r *customClaimResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	subjectID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || subjectID == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form <%s>/<key>, got: %q", r.subject.attribute, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.subject.attribute), subjectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// setClaim sets the planned claim, reporting a failure while action ("creating"
+// or "updating") the resource to diags. It returns whether the claim was set.
+func (r *customClaimResource) setClaim(ctx context.Context, plan customClaimResourceModel, action string, diags *diag.Diagnostics) bool {
+	subjectID := plan.SubjectID.ValueString()
+	key := plan.Key.ValueString()
+	tflog.Debug(ctx, "Setting "+r.subject.name+" custom claim", map[string]any{
+		r.subject.attribute: subjectID,
+		"key":               key,
+	})
+
+	if err := r.subject.setClaim(r.client, ctx, subjectID, key, plan.Value.ValueString()); err != nil {
+		diags.AddError(
+			"Error "+action+" "+r.subject.name+" custom claim",
+			"Could not set claim "+key+" for "+r.subject.name+" "+subjectID+", unexpected error: "+err.Error(),
+		)
+		return false
+	}
+	return true
+}
+
+// get reads the resource data from a plan or state.
+func (r *customClaimResource) get(ctx context.Context, data attributeGetter) (customClaimResourceModel, diag.Diagnostics) {
+	var m customClaimResourceModel
+	var diags diag.Diagnostics
+	diags.Append(data.GetAttribute(ctx, path.Root("id"), &m.ID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root(r.subject.attribute), &m.SubjectID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("key"), &m.Key)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("value"), &m.Value)...)
+	return m, diags
+}
+
+// set writes the resource data to state.
+func (r *customClaimResource) set(ctx context.Context, state *tfsdk.State, m customClaimResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), m.ID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.subject.attribute), m.SubjectID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("key"), m.Key)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("value"), m.Value)...)
+	return diags
+}
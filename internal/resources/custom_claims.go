@@ -4,6 +4,7 @@
 	"context"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -40,10 +41,15 @@ func customClaimsToAPI(ctx context.Context, claims types.Map) ([]client.CustomCl
 }
 
 // customClaimsToState converts the API's list of custom claims into a Terraform
-// map. An empty list is mapped to a null map to avoid perpetual diffs when the
-// configuration omits the attribute.
-func customClaimsToState(ctx context.Context, claims []client.CustomClaim) (types.Map, diag.Diagnostics) {
+// map. While the attribute is managed, see isManaged, an empty list is mapped
+// to an empty map so that custom_claims = {} stays managed; otherwise it is
+// mapped to a null map to avoid perpetual diffs when the configuration omits
+// the attribute.
+func customClaimsToState(ctx context.Context, claims []client.CustomClaim, managed bool) (types.Map, diag.Diagnostics) {
 	if len(claims) == 0 {
+		if managed {
+			return types.MapValueMust(types.StringType, map[string]attr.Value{}), diag.Diagnostics{}
+		}
 		return types.MapNull(types.StringType), diag.Diagnostics{}
 	}
 
@@ -53,3 +59,14 @@ func customClaimsToState(ctx context.Context, claims []client.CustomClaim) (type
 	}
 	return types.MapValueFrom(ctx, types.StringType, values)
 }
+
+// findCustomClaim returns the value of the claim named key and whether it
+// exists.
+func findCustomClaim(claims []client.CustomClaim, key string) (string, bool) {
+	for _, claim := range claims {
+		if claim.Key == key {
+			return claim.Value, true
+		}
+	}
+	return "", false
+}
@@ -45,17 +45,24 @@ func TestCustomClaimsToAPI(t *testing.T) {
 func TestCustomClaimsToState(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("empty slice maps to null", func(t *testing.T) {
-		m, diags := customClaimsToState(ctx, nil)
+	t.Run("empty slice maps to null while unmanaged", func(t *testing.T) {
+		m, diags := customClaimsToState(ctx, nil, false)
 		require.False(t, diags.HasError())
 		assert.True(t, m.IsNull())
 	})
 
+	t.Run("empty slice maps to empty map while managed", func(t *testing.T) {
+		m, diags := customClaimsToState(ctx, nil, true)
+		require.False(t, diags.HasError())
+		require.False(t, m.IsNull())
+		assert.Empty(t, m.Elements())
+	})
+
 	t.Run("populated slice maps to map", func(t *testing.T) {
 		m, diags := customClaimsToState(ctx, []client.CustomClaim{
 			{Key: "department", Value: "engineering"},
 			{Key: "level", Value: "senior"},
-		})
+		}, false)
 		require.False(t, diags.HasError())
 		require.False(t, m.IsNull())
 
@@ -73,7 +80,7 @@ func TestCustomClaimsToState(t *testing.T) {
 			{Key: "a", Value: "1"},
 			{Key: "b", Value: "2"},
 		}
-		m, diags := customClaimsToState(ctx, original)
+		m, diags := customClaimsToState(ctx, original, true)
 		require.False(t, diags.HasError())
 
 		roundTripped, diags := customClaimsToAPI(ctx, m)
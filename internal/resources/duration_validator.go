@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationValidator validates that a string is a Go duration within bounds.
+type durationValidator struct {
+	min, max time.Duration
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a Go duration string such as \"24h\", greater than %s and at most %s", v.min, v.max)
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q must be a Go duration string such as \"15m\" or \"24h\": %s", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+	if d <= v.min || d > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q must be greater than %s and at most %s.", req.ConfigValue.ValueString(), v.min, v.max),
+		)
+	}
+}
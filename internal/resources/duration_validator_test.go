@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationValidator(t *testing.T) {
+	v := durationValidator{min: time.Second, max: 744 * time.Hour}
+
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("24h")},
+		{value: types.StringValue("744h")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("1s"), wantErr: true},
+		{value: types.StringValue("745h"), wantErr: true},
+		{value: types.StringValue("one day"), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("ttl"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// NewGroupCustomClaimResource is a helper function to simplify the provider implementation.
+func NewGroupCustomClaimResource() resource.Resource {
+	return &customClaimResource{subject: groupCustomClaimSubject}
+}
+
+// groupCustomClaimSubject makes a customClaimResource manage a custom claim of
+// a user group.
+var groupCustomClaimSubject = customClaimSubject{
+	typeName:    "_group_custom_claim",
+	attribute:   "group_id",
+	name:        "user group",
+	description: "Manages a single custom claim of a user group in Pocket-ID.",
+	markdownDescription: "Manages a single custom claim of a user group in Pocket-ID. " +
+		"Unlike the `custom_claims` attribute of `pocketid_group`, this resource is not authoritative: " +
+		"it only sets and removes its own claim and leaves the group's other claims untouched, " +
+		"so it can be used for claims also edited in the Pocket-ID UI and for groups that are not managed by Terraform, " +
+		"e.g. groups synced from LDAP. " +
+		"Do not combine it with `custom_claims` on `pocketid_group` for the same group, as that attribute removes claims it does not list.",
+	attributeDescription: "The ID of the user group. Changing this forces a new resource to be created.",
+
+	getClaims: func(c *client.Client, ctx context.Context, groupID string) ([]client.CustomClaim, error) {
+		group, err := c.GetUserGroup(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		return group.CustomClaims, nil
+	},
+	setClaim:    (*client.Client).SetGroupCustomClaim,
+	deleteClaim: (*client.Client).DeleteGroupCustomClaim,
+}
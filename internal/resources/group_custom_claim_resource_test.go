@@ -0,0 +1,86 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewGroupCustomClaimResource(t *testing.T) {
+	r := resources.NewGroupCustomClaimResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithImportState)(nil), r)
+}
+
+func TestGroupCustomClaimResource_Metadata(t *testing.T) {
+	r := resources.NewGroupCustomClaimResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_group_custom_claim", resp.TypeName)
+}
+
+// groupWithClaimsHandler serves a group with the given custom claims and
+// records the claims written back.
+func groupWithClaimsHandler(t *testing.T, claims []client.CustomClaim, written *[]client.CustomClaim) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(client.UserGroup{ID: "group-123", CustomClaims: claims})
+		case "PUT":
+			assert.Equal(t, "/api/custom-claims/user-group/group-123", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(written))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(*written)
+		}
+	}
+}
+
+func TestGroupCustomClaimResource_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	var written []client.CustomClaim
+	testClient := createMockServer(t, groupWithClaimsHandler(t, []client.CustomClaim{{Key: "team", Value: "platform"}}, &written))
+
+	r := resources.NewGroupCustomClaimResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, plan := customClaimState(t, r, "group_id", tftypes.UnknownValue, "group-123", "role", "admin")
+	createResp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), "unexpected diagnostics: %v", createResp.Diagnostics)
+	assert.Equal(t, []client.CustomClaim{{Key: "team", Value: "platform"}, {Key: "role", Value: "admin"}}, written)
+
+	var id string
+	createResp.Diagnostics.Append(createResp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	assert.Equal(t, "group-123/role", id)
+
+	// The mock still serves the original claims, so the claim appears to have
+	// been removed outside of Terraform.
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "unexpected diagnostics: %v", readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+
+	written = nil
+	deleteResp := &resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), "unexpected diagnostics: %v", deleteResp.Diagnostics)
+	assert.Nil(t, written, "deleting a missing claim must not write")
+}
@@ -2,7 +2,9 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +24,7 @@
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupResource{}
 )
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -40,6 +43,7 @@ type groupResourceModel struct {
 	Name         types.String `tfsdk:"name"`
 	FriendlyName types.String `tfsdk:"friendly_name"`
 	CustomClaims types.Map    `tfsdk:"custom_claims"`
+	Members      types.Set    `tfsdk:"members"`
 }
 
 // Metadata returns the resource type name.
@@ -77,10 +81,19 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"custom_claims": schema.MapAttribute{
 				Description:         "Custom claims to include in the OIDC tokens of users in this group, as a map of claim name to value. Reserved claim names (e.g. 'email', 'groups', 'sub') are rejected by Pocket-ID.",
-				MarkdownDescription: "Custom claims to include in the OIDC tokens of users in this group, as a map of claim name to value. Setting this attribute replaces all custom claims for the group. Reserved claim names (e.g. `email`, `groups`, `sub`) are rejected by Pocket-ID.",
+				MarkdownDescription: "Custom claims to include in the OIDC tokens of users in this group, as a map of claim name to value. Setting this attribute replaces all custom claims for the group; when it is not set, custom claims are left unmanaged, e.g. for `pocketid_group_custom_claim`. Removing the attribute keeps the current custom claims; set it to an empty map to remove them all. Reserved claim names (e.g. `email`, `groups`, `sub`) are rejected by Pocket-ID.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"members": schema.SetAttribute{
+				Description: "IDs of the users in the group. Setting this attribute replaces all members of the group; " +
+					"when it is not set, members are left unmanaged. Removing the attribute keeps the current members; set it to an empty set to remove them all.",
+				MarkdownDescription: "IDs of the users in the group. Setting this attribute replaces all members of the group; " +
+					"when it is not set, members are left unmanaged. Removing the attribute keeps the current members; set it to an empty set to remove them all. " +
+					"A plan warns, on a best-effort basis, when it disagrees with the `groups` of a `pocketid_user` about a membership.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -103,6 +116,40 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ModifyPlan warns when the configured members disagree with the groups
+// configured on a pocketid_user.
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed or the provider
+	// is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var id types.String
+	var members types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can only be compared once the group exists and its members
+	// are managed and known.
+	if id.IsUnknown() || members.IsNull() || members.IsUnknown() {
+		return
+	}
+
+	userIDs, diags := knownIDs(ctx, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, userID := range r.client.MembershipClaims().ClaimGroupMembers(id.ValueString(), userIDs) {
+		addMembershipConflictWarning(&resp.Diagnostics, path.Root("members"), userID, id.ValueString(), !slices.Contains(userIDs, userID))
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -124,7 +171,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		"friendlyName": createReq.FriendlyName,
 	})
 
-	groupResp, err := r.client.CreateUserGroup(createReq)
+	groupResp, err := r.client.CreateUserGroup(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating user group",
@@ -151,17 +198,17 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 			tflog.Debug(ctx, "Updating user group custom claims", map[string]any{
 				"id": groupResp.ID,
 			})
-			updatedClaims, err := r.client.UpdateGroupCustomClaims(groupResp.ID, claims)
+			updatedClaims, err := r.client.UpdateGroupCustomClaims(ctx, groupResp.ID, claims)
 			if err != nil {
 				// Try to clean up the created group
-				_ = r.client.DeleteUserGroup(groupResp.ID)
+				_ = r.client.DeleteUserGroup(ctx, groupResp.ID)
 				resp.Diagnostics.AddError(
 					"Error updating user group custom claims",
 					"Could not update user group custom claims, the group was deleted. Error: "+err.Error(),
 				)
 				return
 			}
-			claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims)
+			claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims, isManaged(plan.CustomClaims))
 			resp.Diagnostics.Append(claimDiags...)
 			if resp.Diagnostics.HasError() {
 				return
@@ -170,6 +217,30 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// Handle members
+	if !plan.Members.IsNull() && !plan.Members.IsUnknown() {
+		var userIDs []string
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &userIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(userIDs) > 0 {
+			tflog.Debug(ctx, "Updating user group members", map[string]any{
+				"id":      groupResp.ID,
+				"members": userIDs,
+			})
+			if err := r.client.UpdateUserGroupUsers(ctx, groupResp.ID, userIDs); err != nil {
+				// Try to clean up the created group
+				_ = r.client.DeleteUserGroup(ctx, groupResp.ID)
+				resp.Diagnostics.AddError(
+					"Error updating user group members",
+					"Could not update user group members, the group was deleted. Error: "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
 	// Set the state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -190,8 +261,17 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	})
 
 	// Get group from API
-	groupResp, err := r.client.GetUserGroup(state.ID.ValueString())
+	groupResp, err := r.client.GetUserGroup(ctx, state.ID.ValueString())
 	if err != nil {
+		// The object was deleted outside of Terraform; drop it from state so
+		// the next plan recreates it.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "User group not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading user group",
 			"Could not read user group ID "+state.ID.ValueString()+": "+err.Error(),
@@ -203,13 +283,30 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Name = types.StringValue(groupResp.Name)
 	state.FriendlyName = types.StringValue(groupResp.FriendlyName)
 
-	// Update custom claims
-	claimsMap, claimDiags := customClaimsToState(ctx, groupResp.CustomClaims)
-	resp.Diagnostics.Append(claimDiags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Update custom claims while they are managed, see isManaged.
+	if !isManaged(state.CustomClaims) {
+		tflog.Debug(ctx, "Custom claims are not managed, skipping refresh", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+	} else {
+		claimsMap, claimDiags := customClaimsToState(ctx, groupResp.CustomClaims, isManaged(state.CustomClaims))
+		resp.Diagnostics.Append(claimDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.CustomClaims = claimsMap
+	}
+
+	// Update members while they are managed, see isManaged.
+	if isManaged(state.Members) {
+		userIDs := make([]string, 0, len(groupResp.Users))
+		for _, user := range groupResp.Users {
+			userIDs = append(userIDs, user.ID)
+		}
+		members, membersDiags := types.SetValueFrom(ctx, types.StringType, userIDs)
+		resp.Diagnostics.Append(membersDiags...)
+		state.Members = members
 	}
-	state.CustomClaims = claimsMap
 
 	// Set the state
 	diags = resp.State.Set(ctx, &state)
@@ -244,7 +341,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"friendlyName": updateReq.FriendlyName,
 	})
 
-	_, err := r.client.UpdateUserGroup(plan.ID.ValueString(), updateReq)
+	_, err := r.client.UpdateUserGroup(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating user group",
@@ -253,9 +350,10 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Handle custom claims. The API performs a full replace, so any change to the
-	// map (including clearing it) is applied by sending the full desired list.
-	if !plan.CustomClaims.Equal(state.CustomClaims) {
+	// Handle custom claims while they are managed, see isManaged. The API
+	// performs a full replace, so any change to the map is applied by sending
+	// the full desired list.
+	if isManaged(plan.CustomClaims) && !plan.CustomClaims.Equal(state.CustomClaims) {
 		claims, claimDiags := customClaimsToAPI(ctx, plan.CustomClaims)
 		resp.Diagnostics.Append(claimDiags...)
 		if resp.Diagnostics.HasError() {
@@ -265,7 +363,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		tflog.Debug(ctx, "Updating user group custom claims", map[string]any{
 			"id": plan.ID.ValueString(),
 		})
-		updatedClaims, err := r.client.UpdateGroupCustomClaims(plan.ID.ValueString(), claims)
+		updatedClaims, err := r.client.UpdateGroupCustomClaims(ctx, plan.ID.ValueString(), claims)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating user group custom claims",
@@ -274,7 +372,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims)
+		claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims, isManaged(plan.CustomClaims))
 		resp.Diagnostics.Append(claimDiags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -282,6 +380,28 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.CustomClaims = claimsMap
 	}
 
+	// Handle members while they are managed, see isManaged. The API performs a
+	// full replace.
+	if isManaged(plan.Members) && !plan.Members.IsUnknown() && !plan.Members.Equal(state.Members) {
+		var userIDs []string
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &userIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Updating user group members", map[string]any{
+			"id":      plan.ID.ValueString(),
+			"members": userIDs,
+		})
+		if err := r.client.UpdateUserGroupUsers(ctx, plan.ID.ValueString(), userIDs); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user group members",
+				"Could not update user group members: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Set the state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -302,8 +422,9 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 
 	// Delete the group
-	err := r.client.DeleteUserGroup(state.ID.ValueString())
-	if err != nil {
+	err := r.client.DeleteUserGroup(ctx, state.ID.ValueString())
+	// A user group that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting user group",
 			"Could not delete user group, unexpected error: "+err.Error(),
@@ -320,4 +441,9 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and set it as the resource ID
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// An empty map and set mark the custom claims and members as managed so
+	// Read imports them.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("custom_claims"), types.MapValueMust(types.StringType, nil))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), types.SetValueMust(types.StringType, nil))...)
 }
@@ -0,0 +1,337 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+// configuredResource configures r with c and returns an empty plan with its
+// schema.
+func configuredResource(t *testing.T, r resource.Resource, c *client.Client) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	configResp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, configResp)
+	require.False(t, configResp.Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	return tfsdk.Plan{Schema: schemaResp.Schema}
+}
+
+// objectValue returns a value of the schema's object type in which every
+// attribute is null except the given ones.
+func objectValue(t *testing.T, plan tfsdk.Plan, attrs map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := plan.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	require.True(t, ok)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func stringSet(values ...string) tftypes.Value {
+	elems := make([]tftypes.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, tftypes.NewValue(tftypes.String, v))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+}
+
+// setWithUnknown returns a set of strings holding values and one element that
+// is unknown until apply.
+func setWithUnknown(values ...string) tftypes.Value {
+	elems := []tftypes.Value{tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}
+	for _, v := range values {
+		elems = append(elems, tftypes.NewValue(tftypes.String, v))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+}
+
+func TestGroupResource_Create_Members(t *testing.T) {
+	ctx := context.Background()
+
+	var members []string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/user-groups":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "group-123", "name": "devs", "friendlyName": "Developers"}`))
+		case r.Method == "GET" && r.URL.Path == "/api/user-groups/group-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "group-123", "name": "devs", "friendlyName": "Developers"}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/user-groups/group-123/users":
+			var req client.UpdateUserGroupUsersRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			members = req.UserIDs
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewGroupResource()
+	plan := configuredResource(t, r, testClient)
+	plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":          tftypes.NewValue(tftypes.String, "devs"),
+		"friendly_name": tftypes.NewValue(tftypes.String, "Developers"),
+		"members":       stringSet("user-1", "user-2"),
+	})
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.ElementsMatch(t, []string{"user-1", "user-2"}, members)
+}
+
+func TestGroupResource_Read_Members(t *testing.T) {
+	tests := []struct {
+		name        string
+		members     tftypes.Value
+		wantMembers []string
+	}{
+		{name: "managed", members: stringSet("user-1"), wantMembers: []string{"user-1", "user-2"}},
+		{name: "not managed", members: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{
+					"id": "group-123",
+					"name": "devs",
+					"friendlyName": "Developers",
+					"users": [{"id": "user-1"}, {"id": "user-2"}]
+				}`))
+			})
+
+			r := resources.NewGroupResource()
+			plan := configuredResource(t, r, testClient)
+			state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "group-123"),
+				"members": tt.members,
+			})}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var members []string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("members"), &members).HasError())
+			assert.ElementsMatch(t, tt.wantMembers, members)
+		})
+	}
+}
+
+func TestGroupResource_Update_RemovingMembers(t *testing.T) {
+	tests := []struct {
+		name        string
+		members     tftypes.Value
+		wantUpdated bool
+	}{
+		// Removing the attribute stops managing the members and keeps them.
+		{name: "removed", members: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)},
+		{name: "emptied", members: stringSet(), wantUpdated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var members []string
+			membersUpdated := false
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/user-groups/group-123":
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"id": "group-123", "name": "devs", "friendlyName": "Developers", "users": [{"id": "user-1"}]}`))
+				case "/api/user-groups/group-123/users":
+					var req client.UpdateUserGroupUsersRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					members, membersUpdated = req.UserIDs, true
+					w.WriteHeader(http.StatusOK)
+				}
+			})
+
+			r := resources.NewGroupResource()
+			plan := configuredResource(t, r, testClient)
+			attrs := map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "group-123"),
+				"name":          tftypes.NewValue(tftypes.String, "devs"),
+				"friendly_name": tftypes.NewValue(tftypes.String, "Developers"),
+				"members":       tt.members,
+			}
+			plan.Raw = objectValue(t, plan, attrs)
+			attrs["members"] = stringSet("user-1")
+			state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, attrs)}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			assert.Equal(t, tt.wantUpdated, membersUpdated)
+			assert.Empty(t, members)
+		})
+	}
+}
+
+// State written by earlier versions holds the custom claims of groups whose
+// configuration never set custom_claims. Removing the attribute must keep them.
+func TestGroupResource_Update_RemovingCustomClaimsKeepsThem(t *testing.T) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/api/user-groups/group-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "group-123", "name": "devs", "friendlyName": "Developers"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewGroupResource()
+	plan := configuredResource(t, r, testClient)
+	attrs := map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, "group-123"),
+		"name":          tftypes.NewValue(tftypes.String, "devs"),
+		"friendly_name": tftypes.NewValue(tftypes.String, "Developers"),
+	}
+	plan.Raw = objectValue(t, plan, attrs)
+	attrs["custom_claims"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "platform"),
+	})
+	state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, attrs)}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+}
+
+func TestGroupResource_ImportState_ManagesMembers(t *testing.T) {
+	ctx := context.Background()
+	r := resources.NewGroupResource()
+	plan := configuredResource(t, r, nil)
+	state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}
+
+	resp := &resource.ImportStateResponse{State: state}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "group-123"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var members types.Set
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("members"), &members)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.False(t, members.IsNull(), "an empty set makes Read import the members")
+}
+
+// ImportState marks custom_claims as managed with an empty map, which a Read
+// of a group without claims must keep.
+func TestGroupResource_ImportState_KeepsEmptyCustomClaims(t *testing.T) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/user-groups/group-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "group-123", "name": "devs", "friendlyName": "Developers", "customClaims": []}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewGroupResource()
+	plan := configuredResource(t, r, testClient)
+	state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}
+
+	importResp := &resource.ImportStateResponse{State: state}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "group-123"}, importResp)
+	require.False(t, importResp.Diagnostics.HasError(), "unexpected diagnostics: %v", importResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "unexpected diagnostics: %v", readResp.Diagnostics)
+
+	var claims types.Map
+	require.False(t, readResp.State.GetAttribute(ctx, path.Root("custom_claims"), &claims).HasError())
+	assert.False(t, claims.IsNull(), "custom_claims must stay managed")
+	assert.Empty(t, claims.Elements())
+}
+
+// A membership set from both the user side and the group side is reported by
+// whichever resource is planned last.
+func TestGroupResource_ModifyPlan_MembershipConflict(t *testing.T) {
+	tests := []struct {
+		name        string
+		userGroups  tftypes.Value
+		members     tftypes.Value
+		wantWarning bool
+	}{
+		{name: "agree", userGroups: stringSet("group-123"), members: stringSet("user-123")},
+		{name: "user lists group", userGroups: stringSet("group-123"), members: stringSet(), wantWarning: true},
+		{name: "group lists user", userGroups: stringSet(), members: stringSet("user-123"), wantWarning: true},
+		{name: "user groups not managed", userGroups: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil), members: stringSet()},
+		{name: "unknown group", userGroups: setWithUnknown("group-123"), members: stringSet("user-123")},
+		{name: "unknown member", userGroups: stringSet("group-123"), members: setWithUnknown("user-123")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			})
+
+			userResource := resources.NewUserResource()
+			userPlan := configuredResource(t, userResource, testClient)
+			userPlan.Raw = objectValue(t, userPlan, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "user-123"),
+				"groups": tt.userGroups,
+			})
+			userResp := &resource.ModifyPlanResponse{Plan: userPlan}
+			userResource.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: userPlan}, userResp)
+			assert.Empty(t, userResp.Diagnostics, "the first resource planned has nothing to compare against")
+
+			groupResource := resources.NewGroupResource()
+			groupPlan := configuredResource(t, groupResource, testClient)
+			groupPlan.Raw = objectValue(t, groupPlan, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "group-123"),
+				"members": tt.members,
+			})
+			groupResp := &resource.ModifyPlanResponse{Plan: groupPlan}
+			groupResource.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: groupPlan}, groupResp)
+
+			assert.False(t, groupResp.Diagnostics.HasError())
+			if tt.wantWarning {
+				require.Len(t, groupResp.Diagnostics.Warnings(), 1)
+				assert.Equal(t, "Conflicting Group Membership", groupResp.Diagnostics.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, groupResp.Diagnostics.Warnings())
+			}
+		})
+	}
+}
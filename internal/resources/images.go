@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// imageExtensions maps the content types detected by http.DetectContentType to
+// the file extension Pocket-ID uses to recognise the image type.
+var imageExtensions = map[string]string{
+	"image/png":                ".png",
+	"image/jpeg":               ".jpg",
+	"image/gif":                ".gif",
+	"image/webp":               ".webp",
+	"image/x-icon":             ".ico",
+	"image/vnd.microsoft.icon": ".ico",
+}
+
+// loadImage returns the file name to upload and the content of an image that
+// is configured either as a file path or as base64-encoded content. The
+// content is nil when neither is set.
+func loadImage(file, b64 types.String) (string, []byte, error) {
+	switch {
+	case !file.IsNull():
+		content, err := os.ReadFile(file.ValueString()) // #nosec G304 -- the path is chosen by the practitioner
+		if err != nil {
+			return "", nil, err
+		}
+		return filepath.Base(file.ValueString()), content, nil
+	case !b64.IsNull():
+		content, err := base64.StdEncoding.DecodeString(b64.ValueString())
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid base64 content: %w", err)
+		}
+		ext, err := imageExtension(content)
+		if err != nil {
+			return "", nil, err
+		}
+		return "image" + ext, content, nil
+	default:
+		return "", nil, nil
+	}
+}
+
+// imageExtension detects the file extension of base64-configured content,
+// which has no file name to take it from.
+func imageExtension(content []byte) (string, error) {
+	if ext, ok := imageExtensions[http.DetectContentType(content)]; ok {
+		return ext, nil
+	}
+	if bytes.Contains(content, []byte("<svg")) {
+		return ".svg", nil
+	}
+	return "", fmt.Errorf("unable to detect the image type; supported types are PNG, JPEG, GIF, WebP, ICO and SVG")
+}
+
+// contentHash returns the hex-encoded SHA-256 hash of content, used to detect
+// images that were changed outside of Terraform.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// plannedImageHash returns the hash of the image configured by the <name>_file
+// and <name>_base64 attributes. It is unknown while either value is unknown
+// and null when no image is configured. Read errors are reported on the
+// attribute that was set.
+func plannedImageHash(name string, file, b64 types.String, diags *diag.Diagnostics) types.String {
+	if file.IsUnknown() || b64.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	_, content, err := loadImage(file, b64)
+	if err != nil {
+		attr := name + "_base64"
+		if !file.IsNull() {
+			attr = name + "_file"
+		}
+		diags.AddAttributeError(path.Root(attr), "Unable to Read Image", err.Error())
+		return types.StringUnknown()
+	}
+	if content == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(contentHash(content))
+}
+
+// syncImage brings an image in line with the configuration and returns its
+// new hash. The image is uploaded only when its hash differs from current, and
+// removed when no image is configured any more.
+func syncImage(file, b64, current types.String, upload func(fileName string, content []byte) error, remove func() error) (types.String, error) {
+	fileName, content, err := loadImage(file, b64)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if content == nil {
+		if current.IsNull() {
+			return types.StringNull(), nil
+		}
+		return types.StringNull(), remove()
+	}
+
+	hash := contentHash(content)
+	if current.ValueString() == hash {
+		return current, nil
+	}
+	if err := upload(fileName, content); err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(hash), nil
+}
@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is the signature of a PNG file, enough for content detection.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestLoadImage(t *testing.T) {
+	dir := t.TempDir()
+	logoPath := filepath.Join(dir, "logo.svg")
+	require.NoError(t, os.WriteFile(logoPath, []byte("<svg></svg>"), 0o600))
+
+	tests := []struct {
+		name         string
+		file         types.String
+		b64          types.String
+		wantFileName string
+		wantContent  []byte
+		wantErr      bool
+	}{
+		{
+			name:         "file",
+			file:         types.StringValue(logoPath),
+			b64:          types.StringNull(),
+			wantFileName: "logo.svg",
+			wantContent:  []byte("<svg></svg>"),
+		},
+		{
+			name:         "base64 png",
+			file:         types.StringNull(),
+			b64:          types.StringValue(base64.StdEncoding.EncodeToString(pngHeader)),
+			wantFileName: "image.png",
+			wantContent:  pngHeader,
+		},
+		{
+			name:         "base64 svg",
+			file:         types.StringNull(),
+			b64:          types.StringValue(base64.StdEncoding.EncodeToString([]byte(`<?xml version="1.0"?><svg></svg>`))),
+			wantFileName: "image.svg",
+			wantContent:  []byte(`<?xml version="1.0"?><svg></svg>`),
+		},
+		{
+			name: "not set",
+			file: types.StringNull(),
+			b64:  types.StringNull(),
+		},
+		{
+			name:    "missing file",
+			file:    types.StringValue(filepath.Join(dir, "missing.png")),
+			b64:     types.StringNull(),
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			file:    types.StringNull(),
+			b64:     types.StringValue("not base64!"),
+			wantErr: true,
+		},
+		{
+			name:    "unknown image type",
+			file:    types.StringNull(),
+			b64:     types.StringValue(base64.StdEncoding.EncodeToString([]byte("plain text"))),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileName, content, err := loadImage(tt.file, tt.b64)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFileName, fileName)
+			assert.Equal(t, tt.wantContent, content)
+		})
+	}
+}
+
+func TestPlannedImageHash(t *testing.T) {
+	b64 := types.StringValue(base64.StdEncoding.EncodeToString(pngHeader))
+
+	var diags diag.Diagnostics
+	assert.Equal(t, types.StringValue(contentHash(pngHeader)), plannedImageHash("logo", types.StringNull(), b64, &diags))
+	assert.True(t, plannedImageHash("logo", types.StringUnknown(), types.StringNull(), &diags).IsUnknown())
+	assert.True(t, plannedImageHash("logo", types.StringNull(), types.StringNull(), &diags).IsNull())
+	assert.False(t, diags.HasError())
+
+	plannedImageHash("logo", types.StringValue(filepath.Join(t.TempDir(), "missing.png")), types.StringNull(), &diags)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unable to Read Image", diags[0].Summary())
+}
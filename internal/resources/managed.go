@@ -0,0 +1,21 @@
+package resources
+
+import "github.com/hashicorp/terraform-plugin-framework/attr"
+
+// isManaged reports whether a resource manages an attribute that replaces a
+// complete list in Pocket-ID: the groups and custom_claims of pocketid_user,
+// the members and custom_claims of pocketid_group, and the allowed_user_groups
+// of pocketid_client. Such an attribute is only managed while it is set, so
+// entries added in other ways, e.g. by pocketid_user_group_membership,
+// pocketid_client_allowed_group or the custom claim resources, are left alone:
+//
+//   - Read refreshes the attribute only while it is in the state, so those
+//     entries are not reported as drift and removed.
+//   - Update writes the attribute only while it is in the plan. Removing it
+//     stops managing the entries and keeps them. This also protects state
+//     written by earlier versions, which always refreshed these attributes;
+//     an empty value removes all entries.
+//   - ImportState sets an empty value, so Read imports the entries.
+func isManaged(value attr.Value) bool {
+	return !value.IsNull()
+}
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// addMembershipConflictWarning reports that pocketid_user.groups and
+// pocketid_group.members disagree about whether the user is in the group.
+// inGroups tells whether the user's groups include the group.
+func addMembershipConflictWarning(diags *diag.Diagnostics, p path.Path, userID, groupID string, inGroups bool) {
+	usersSide, groupsSide := "does not list", "lists"
+	if inGroups {
+		usersSide, groupsSide = "lists", "does not list"
+	}
+
+	diags.AddAttributeWarning(
+		p,
+		"Conflicting Group Membership",
+		fmt.Sprintf("The groups of pocketid_user %s %s group %s, but the members of that pocketid_group %s the user. "+
+			"Both attributes replace the complete membership list, so each apply undoes the other's change. "+
+			"Manage the membership from one side only, or use pocketid_user_group_membership. "+
+			"This check is best-effort: it only sees the resources planned before this one, so which resource reports a conflict can change between runs, "+
+			"and a conflict is missed when only one side is planned, e.g. with -target.",
+			userID, usersSide, groupID, groupsSide),
+	)
+}
+
+// knownIDs returns the known IDs in a planned set of IDs. IDs that are unknown
+// until apply, such as the ID of a group created in the same apply, are
+// skipped.
+func knownIDs(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var elems []types.String
+	diags := set.ElementsAs(ctx, &elems, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		if elem.IsUnknown() || elem.IsNull() {
+			continue
+		}
+		ids = append(ids, elem.ValueString())
+	}
+	return ids, diags
+}
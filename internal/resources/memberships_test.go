@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddMembershipConflictWarning(t *testing.T) {
+	var diags diag.Diagnostics
+	addMembershipConflictWarning(&diags, path.Root("groups"), "user-1", "group-a", true)
+
+	require.Len(t, diags.Warnings(), 1)
+	assert.Contains(t, diags.Warnings()[0].Detail(), "pocketid_user user-1 lists group group-a")
+	assert.Contains(t, diags.Warnings()[0].Detail(), "pocketid_group does not list the user")
+}
@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+// notFoundResources lists the resources backed by a server-side object, with
+// the attributes needed to identify that object in state.
+var notFoundResources = []struct {
+	name  string
+	new   func() resource.Resource
+	attrs map[string]string
+}{
+	{name: "user", new: resources.NewUserResource, attrs: map[string]string{"id": "user-123"}},
+	{name: "group", new: resources.NewGroupResource, attrs: map[string]string{"id": "group-123"}},
+	{name: "client", new: resources.NewClientResource, attrs: map[string]string{"id": "client-123"}},
+	{name: "api_key", new: resources.NewAPIKeyResource, attrs: map[string]string{"id": "key-123"}},
+	{name: "signup_token", new: resources.NewSignupTokenResource, attrs: map[string]string{"id": "st-123"}},
+	{name: "client_allowed_group", new: resources.NewClientAllowedGroupResource, attrs: map[string]string{"id": "client-123/group-123", "client_id": "client-123", "group_id": "group-123"}},
+	{name: "client_logo", new: resources.NewClientLogoResource, attrs: map[string]string{"id": "client-123"}},
+	{name: "user_group_membership", new: resources.NewUserGroupMembershipResource, attrs: map[string]string{"id": "user-123/group-123", "user_id": "user-123", "group_id": "group-123"}},
+	{name: "user_custom_claim", new: resources.NewUserCustomClaimResource, attrs: map[string]string{"id": "user-123/role", "user_id": "user-123", "key": "role"}},
+	{name: "group_custom_claim", new: resources.NewGroupCustomClaimResource, attrs: map[string]string{"id": "group-123/role", "group_id": "group-123", "key": "role"}},
+	{name: "user_profile_picture", new: resources.NewUserProfilePictureResource, attrs: map[string]string{"id": "user-123", "user_id": "user-123"}},
+	{name: "scim_service_provider", new: resources.NewScimServiceProviderResource, attrs: map[string]string{"id": "scim-123", "client_id": "client-123"}},
+}
+
+// configuredNotFoundResource returns the resource configured against a server
+// that answers every request with 404, along with a prior state.
+func configuredNotFoundResource(t *testing.T, newResource func() resource.Resource, attrs map[string]string) (resource.Resource, tfsdk.State) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		if _, err := fmt.Fprint(w, `{"error": "Record not found"}`); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	})
+
+	r := newResource()
+	configResp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, configResp)
+	require.False(t, configResp.Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// Every attribute is null except the identifying ones.
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attrs {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	return r, state
+}
+
+// A resource deleted outside of Terraform must be removed from state on Read
+// so the next plan recreates it instead of failing.
+func TestResources_Read_RemovesResourceWhenNotFound(t *testing.T) {
+	for _, tt := range notFoundResources {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, state := configuredNotFoundResource(t, tt.new, tt.attrs)
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull(), "resource should be removed from state")
+		})
+	}
+}
+
+// Destroying a resource that was already deleted outside of Terraform must
+// succeed.
+func TestResources_Delete_SucceedsWhenNotFound(t *testing.T) {
+	for _, tt := range notFoundResources {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, state := configuredNotFoundResource(t, tt.new, tt.attrs)
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &oneTimeAccessEmailResource{}
+	_ resource.ResourceWithConfigure = &oneTimeAccessEmailResource{}
+)
+
+// NewOneTimeAccessEmailResource is a helper function to simplify the provider implementation.
+func NewOneTimeAccessEmailResource() resource.Resource {
+	return &oneTimeAccessEmailResource{}
+}
+
+// oneTimeAccessEmailResource defines the resource implementation.
+type oneTimeAccessEmailResource struct {
+	client *client.Client
+}
+
+// oneTimeAccessEmailResourceModel maps the resource schema data.
+type oneTimeAccessEmailResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	UserID    types.String `tfsdk:"user_id"`
+	TTL       types.String `tfsdk:"ttl"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	SentAt    types.String `tfsdk:"sent_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *oneTimeAccessEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_one_time_access_email"
+}
+
+func (r *oneTimeAccessEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes Pocket-ID email a one-time login link to a user. Unlike `pocketid_one_time_access_token`, " +
+			"the token never reaches Terraform or its state. This is an action resource: applying it sends the email, and " +
+			"changing any argument sends a new one (the resource is recreated). SMTP must be configured and " +
+			"`email_one_time_access_as_admin_enabled` must be `true` in the application configuration, otherwise the " +
+			"apply fails. A sent link cannot be recalled, so destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the email resource (same as `user_id`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the login link is sent to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Lifetime of the login link expressed as a Go duration string (e.g. `15m`, `1h`, `24h`). " +
+					"Must be greater than 1 second and at most 744h (31 days). Defaults to `1h`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("1h"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					durationValidator{min: time.Second, max: maxOneTimeAccessTokenTTL},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that sends a new email when it changes. If omitted, the " +
+					"email is sent only once (on create).",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of the most recent email sent by this resource.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The computed expiration time of the login link in RFC3339 format (sent_at + ttl).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *oneTimeAccessEmailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *oneTimeAccessEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan oneTimeAccessEmailResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The application configuration is checked at apply time rather than plan
+	// time, so SMTP and the feature can be enabled by pocketid_application_config
+	// in the same apply.
+	cfg, err := r.client.GetApplicationConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading application configuration",
+			"Could not check whether one-time access emails are enabled: "+err.Error(),
+		)
+		return
+	}
+	if problems := oneTimeAccessEmailProblems(cfg); len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"One-Time Access Emails Disabled",
+			"Pocket-ID cannot send one-time access emails: "+strings.Join(problems, ", and ")+". "+
+				"Enable it in the application configuration, e.g. with pocketid_application_config, and apply again.",
+		)
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	ttlStr := plan.TTL.ValueString()
+	// The validator has already accepted the ttl.
+	ttl, _ := time.ParseDuration(ttlStr)
+
+	tflog.Debug(ctx, "sending one-time access email", map[string]any{
+		"user_id": userID,
+		"ttl":     ttlStr,
+	})
+	if err := r.client.SendOneTimeAccessEmail(ctx, userID, &client.OneTimeAccessTokenRequest{TTL: ttlStr}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error sending one-time access email",
+			fmt.Sprintf("Could not send a one-time access email to user %s: %s", userID, err.Error()),
+		)
+		return
+	}
+
+	sent := time.Now().UTC()
+	plan.ID = plan.UserID
+	plan.SentAt = types.StringValue(sent.Format(time.RFC3339))
+	plan.ExpiresAt = types.StringValue(sent.Add(ttl).Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *oneTimeAccessEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// An email is an action with no readable server-side state; preserve prior state.
+	var data oneTimeAccessEmailResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oneTimeAccessEmailResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes force replacement, so Update is never expected.
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"pocketid_one_time_access_email cannot be updated in place. Change the triggers map to send a new email.",
+	)
+}
+
+func (r *oneTimeAccessEmailResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A sent email cannot be recalled; there is nothing to delete server-side.
+	tflog.Trace(ctx, "removing pocketid_one_time_access_email from state (no server-side action)")
+}
+
+// oneTimeAccessEmailProblems returns why the application configuration keeps
+// Pocket-ID from sending one-time access emails as an admin.
+func oneTimeAccessEmailProblems(cfg *client.ApplicationConfig) []string {
+	var problems []string
+	if cfg.SmtpHost == "" {
+		problems = append(problems, "SMTP is not configured (smtp_host is empty)")
+	}
+	if cfg.EmailOneTimeAccessAsAdminEnabled != "true" {
+		problems = append(problems, "email_one_time_access_as_admin_enabled is not true")
+	}
+	return problems
+}
@@ -0,0 +1,118 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewOneTimeAccessEmailResource(t *testing.T) {
+	r := resources.NewOneTimeAccessEmailResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithConfigure)(nil), r)
+}
+
+func TestOneTimeAccessEmailResource_Metadata(t *testing.T) {
+	r := resources.NewOneTimeAccessEmailResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.TODO(), resource.MetadataRequest{ProviderTypeName: "pocketid"}, resp)
+
+	assert.Equal(t, "pocketid_one_time_access_email", resp.TypeName)
+}
+
+func TestOneTimeAccessEmailResource_Schema(t *testing.T) {
+	r := resources.NewOneTimeAccessEmailResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["ttl"].IsOptional())
+	assert.True(t, schema.Attributes["triggers"].IsOptional())
+	assert.True(t, schema.Attributes["sent_at"].IsComputed())
+	assert.True(t, schema.Attributes["expires_at"].IsComputed())
+	assert.False(t, schema.Attributes["expires_at"].IsSensitive(), "no secret is stored")
+}
+
+func TestOneTimeAccessEmailResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		smtpHost      string
+		adminEnabled  string
+		sendStatus    int
+		wantSent      bool
+		errorContains string
+	}{
+		{name: "enabled", smtpHost: "smtp.example.com", adminEnabled: "true", sendStatus: http.StatusNoContent, wantSent: true},
+		{name: "smtp not configured", adminEnabled: "true", errorContains: "SMTP is not configured"},
+		{name: "feature disabled", smtpHost: "smtp.example.com", adminEnabled: "false", errorContains: "email_one_time_access_as_admin_enabled is not true"},
+		{name: "server error", smtpHost: "smtp.example.com", adminEnabled: "true", sendStatus: http.StatusBadRequest, wantSent: true, errorContains: "Could not send"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			sent := false
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/api/application-configuration/all":
+					w.Header().Set("Content-Type", "application/json")
+					require.NoError(t, json.NewEncoder(w).Encode([]client.AppConfigVariable{
+						{Key: "smtpHost", Value: tt.smtpHost},
+						{Key: "emailOneTimeAccessAsAdminEnabled", Value: tt.adminEnabled},
+					}))
+				case r.Method == "POST" && r.URL.Path == "/api/users/user-123/one-time-access-email":
+					sent = true
+					var body map[string]string
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, "15m", body["ttl"])
+					w.WriteHeader(tt.sendStatus)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			r := resources.NewOneTimeAccessEmailResource()
+			plan := configuredResource(t, r, testClient)
+			plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"user_id":    tftypes.NewValue(tftypes.String, "user-123"),
+				"ttl":        tftypes.NewValue(tftypes.String, "15m"),
+				"sent_at":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"expires_at": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			assert.Equal(t, tt.wantSent, sent)
+			if tt.errorContains != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.errorContains)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var id, sentAt, expiresAt string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("sent_at"), &sentAt).HasError())
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt).HasError())
+			assert.Equal(t, "user-123", id)
+			assert.NotEmpty(t, sentAt)
+			assert.Greater(t, expiresAt, sentAt)
+		})
+	}
+}
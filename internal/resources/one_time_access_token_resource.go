@@ -10,6 +10,7 @@
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -72,6 +73,9 @@ func (r *OneTimeAccessTokenResource) Schema(ctx context.Context, req resource.Sc
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					durationValidator{min: time.Second, max: maxOneTimeAccessTokenTTL},
+				},
 			},
 			"token": schema.StringAttribute{
 				MarkdownDescription: "The one-time access token value. Returned only on creation.",
@@ -116,22 +120,14 @@ func (r *OneTimeAccessTokenResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	// Validate the ttl duration up front to give a clear error before calling the API.
+	// The ttl has been checked by durationValidator.
 	ttlStr := data.TTL.ValueString()
 	ttl, err := time.ParseDuration(ttlStr)
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("ttl"),
-			"Invalid ttl",
-			fmt.Sprintf("The ttl value must be a Go duration string such as \"15m\" or \"1h\": %s", err),
-		)
-		return
-	}
-	if ttl <= time.Second || ttl > maxOneTimeAccessTokenTTL {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("ttl"),
-			"Invalid ttl",
-			"The ttl must be greater than 1 second and at most 744h (31 days).",
+			"Invalid Duration",
+			fmt.Sprintf("The value %q must be a Go duration string such as \"15m\" or \"24h\": %s", ttlStr, err),
 		)
 		return
 	}
@@ -141,7 +137,7 @@ func (r *OneTimeAccessTokenResource) Create(ctx context.Context, req resource.Cr
 		"ttl":     ttlStr,
 	})
 
-	token, err := r.client.CreateOneTimeAccessToken(data.UserID.ValueString(), &client.OneTimeAccessTokenRequest{TTL: ttlStr})
+	token, err := r.client.CreateOneTimeAccessToken(ctx, data.UserID.ValueString(), &client.OneTimeAccessTokenRequest{TTL: ttlStr})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating one-time access token",
@@ -4,7 +4,9 @@
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -66,6 +68,15 @@ func TestOneTimeAccessTokenResource_Schema(t *testing.T) {
 	assert.True(t, ttlAttr.IsRequired())
 	assert.False(t, ttlAttr.IsComputed())
 
+	// The ttl is limited to 31 days by the shared duration validator.
+	ttlValidators := ttlAttr.(interface{ StringValidators() []validator.String }).StringValidators()
+	require.Len(t, ttlValidators, 1)
+	for value, wantErr := range map[string]bool{"15m": false, "744h": false, "1s": true, "745h": true, "soon": true} {
+		validateResp := &validator.StringResponse{}
+		ttlValidators[0].ValidateString(context.TODO(), validator.StringRequest{Path: path.Root("ttl"), ConfigValue: types.StringValue(value)}, validateResp)
+		assert.Equal(t, wantErr, validateResp.Diagnostics.HasError(), value)
+	}
+
 	tokenAttr := schema.Attributes["token"]
 	assert.True(t, tokenAttr.IsComputed())
 	assert.True(t, tokenAttr.IsSensitive())
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -136,7 +137,7 @@ func (r *scimServiceProviderResource) Create(ctx context.Context, req resource.C
 		"endpoint":  createReq.Endpoint,
 	})
 
-	providerResp, err := r.client.CreateScimServiceProvider(createReq)
+	providerResp, err := r.client.CreateScimServiceProvider(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating SCIM service provider",
@@ -165,8 +166,17 @@ func (r *scimServiceProviderResource) Read(ctx context.Context, req resource.Rea
 		"client_id": state.ClientID.ValueString(),
 	})
 
-	providerResp, err := r.client.GetClientScimServiceProvider(state.ClientID.ValueString())
+	providerResp, err := r.client.GetClientScimServiceProvider(ctx, state.ClientID.ValueString())
 	if err != nil {
+		// The object was deleted outside of Terraform; drop it from state so
+		// the next plan recreates it.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "SCIM service provider not found, removing from state", map[string]any{
+				"client_id": state.ClientID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading SCIM service provider",
 			"Could not read SCIM service provider for client ID "+state.ClientID.ValueString()+": "+err.Error(),
@@ -208,7 +218,7 @@ func (r *scimServiceProviderResource) Update(ctx context.Context, req resource.U
 		"endpoint":  updateReq.Endpoint,
 	})
 
-	providerResp, err := r.client.UpdateScimServiceProvider(state.ID.ValueString(), updateReq)
+	providerResp, err := r.client.UpdateScimServiceProvider(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating SCIM service provider",
@@ -236,8 +246,9 @@ func (r *scimServiceProviderResource) Delete(ctx context.Context, req resource.D
 		"id": state.ID.ValueString(),
 	})
 
-	err := r.client.DeleteScimServiceProvider(state.ID.ValueString())
-	if err != nil {
+	err := r.client.DeleteScimServiceProvider(ctx, state.ID.ValueString())
+	// A SCIM service provider that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting SCIM service provider",
 			"Could not delete SCIM service provider, unexpected error: "+err.Error(),
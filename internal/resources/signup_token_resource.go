@@ -0,0 +1,344 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// maxSignupTokenUsageLimit mirrors the pocket-id API limit.
+const maxSignupTokenUsageLimit = 100
+
+// maxSignupTokenTTL caps the lifetime of signup tokens. Invitations older than
+// a month are rarely still wanted, so the same 31 days as one-time access
+// tokens apply.
+const maxSignupTokenTTL = 31 * 24 * time.Hour
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &signupTokenResource{}
+	_ resource.ResourceWithConfigure   = &signupTokenResource{}
+	_ resource.ResourceWithImportState = &signupTokenResource{}
+)
+
+// NewSignupTokenResource is a helper function to simplify the provider implementation.
+func NewSignupTokenResource() resource.Resource {
+	return &signupTokenResource{}
+}
+
+// signupTokenResource is the resource implementation.
+type signupTokenResource struct {
+	client *client.Client
+}
+
+// signupTokenResourceModel maps the resource schema data.
+type signupTokenResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TTL        types.String `tfsdk:"ttl"`
+	UsageLimit types.Int64  `tfsdk:"usage_limit"`
+	UserGroups types.Set    `tfsdk:"user_groups"`
+	Token      types.String `tfsdk:"token"`
+	SignupURL  types.String `tfsdk:"signup_url"`
+	UsageCount types.Int64  `tfsdk:"usage_count"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *signupTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signup_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *signupTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a signup token in Pocket-ID.",
+		MarkdownDescription: "Manages a signup token in Pocket-ID. Signup tokens let people create an account while open signups " +
+			"are disabled in the application configuration. Pocket-ID cannot update signup tokens: changing any argument deletes " +
+			"the token and creates a new one. A token that expired and was cleaned up by Pocket-ID is created again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the signup token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Description: "Lifetime of the token expressed as a Go duration string (e.g. 24h or 168h). " +
+					"Must be greater than 1 second and at most 744h (31 days). Changing this forces a new token to be created, " +
+					"except on an imported token, whose ttl cannot be read back and is only recorded.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// An imported token has no ttl in state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the ttl forces a new token to be created, unless the token was imported.",
+						"Changing the ttl forces a new token to be created, unless the token was imported.",
+					),
+				},
+				Validators: []validator.String{
+					durationValidator{min: time.Second, max: maxSignupTokenTTL},
+				},
+			},
+			"usage_limit": schema.Int64Attribute{
+				Description: "How many accounts can be created with the token. Defaults to 1. " +
+					"Changing this forces a new token to be created.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxSignupTokenUsageLimit),
+				},
+			},
+			"user_groups": schema.SetAttribute{
+				Description: "IDs of the user groups that accounts created with the token are added to. " +
+					"Changing this forces a new token to be created.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The signup token.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signup_url": schema.StringAttribute{
+				Description: "The URL at which the token is redeemed, built from the provider's base_url.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_count": schema.Int64Attribute{
+				Description: "How many accounts have been created with the token.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The expiration time of the token in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation time of the token in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *signupTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *signupTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan signupTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &client.SignupTokenCreateRequest{
+		TTL:        plan.TTL.ValueString(),
+		UsageLimit: plan.UsageLimit.ValueInt64(),
+	}
+	if !plan.UserGroups.IsNull() {
+		resp.Diagnostics.Append(plan.UserGroups.ElementsAs(ctx, &createReq.UserGroupIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Creating signup token", map[string]any{
+		"ttl":         createReq.TTL,
+		"usage_limit": createReq.UsageLimit,
+		"user_groups": createReq.UserGroupIDs,
+	})
+
+	token, err := r.client.CreateSignupToken(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating signup token",
+			"Could not create signup token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapSignupTokenToState(ctx, &plan, token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *signupTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state signupTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading signup token", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	token, err := r.client.GetSignupToken(ctx, state.ID.ValueString())
+	if err != nil {
+		// The token was deleted outside of Terraform or cleaned up after it
+		// expired; drop it from state so the next plan creates a new one.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "Signup token not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading signup token",
+			"Could not read signup token ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapSignupTokenToState(ctx, &state, token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update records the ttl of an imported token. Every other change replaces the
+// token.
+func (r *signupTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state signupTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument forces replacement, except the ttl of an imported token,
+	// which Pocket-ID cannot return. It is recorded without touching the token.
+	if !state.TTL.IsNull() {
+		resp.Diagnostics.AddError(
+			"Update not supported",
+			"Signup tokens cannot be updated. To change a signup token, delete and recreate it.",
+		)
+		return
+	}
+
+	state.TTL = plan.TTL
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the signup token and removes the Terraform state on success.
+func (r *signupTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state signupTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting signup token", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	err := r.client.DeleteSignupToken(ctx, state.ID.ValueString())
+	// A signup token that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting signup token",
+			"Could not delete signup token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing signup token by ID. The ttl cannot be
+// recovered and is left unset; the next apply records the configured ttl
+// without replacing the token.
+func (r *signupTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapSignupTokenToState maps a signup token onto the resource model. The
+// token value and the user groups are kept from state when the API omits them.
+func (r *signupTokenResource) mapSignupTokenToState(ctx context.Context, model *signupTokenResourceModel, token *client.SignupToken) diag.Diagnostics {
+	model.ID = types.StringValue(token.ID)
+	model.UsageLimit = types.Int64Value(token.UsageLimit)
+	model.UsageCount = types.Int64Value(token.UsageCount)
+	model.ExpiresAt = types.StringValue(token.ExpiresAt)
+	model.CreatedAt = optionalString(token.CreatedAt)
+
+	if token.Token != "" {
+		model.Token = types.StringValue(token.Token)
+		model.SignupURL = types.StringValue(r.client.SignupURL(token.Token))
+	}
+
+	if len(token.UserGroups) > 0 {
+		groupIDs := make([]string, len(token.UserGroups))
+		for i, group := range token.UserGroups {
+			groupIDs[i] = group.ID
+		}
+		var diags diag.Diagnostics
+		model.UserGroups, diags = types.SetValueFrom(ctx, types.StringType, groupIDs)
+		return diags
+	}
+	return nil
+}
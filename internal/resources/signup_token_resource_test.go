@@ -0,0 +1,244 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewSignupTokenResource(t *testing.T) {
+	r := resources.NewSignupTokenResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.Resource)(nil), r)
+}
+
+func TestSignupTokenResource_Metadata(t *testing.T) {
+	r := resources.NewSignupTokenResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_signup_token", resp.TypeName)
+}
+
+func TestSignupTokenResource_Schema(t *testing.T) {
+	r := resources.NewSignupTokenResource()
+
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.TODO(), req, resp)
+
+	schema := resp.Schema
+	assert.NotNil(t, schema)
+
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["ttl"].IsRequired())
+	assert.True(t, schema.Attributes["usage_limit"].IsOptional())
+	assert.True(t, schema.Attributes["user_groups"].IsOptional())
+	assert.True(t, schema.Attributes["usage_count"].IsComputed())
+	assert.True(t, schema.Attributes["expires_at"].IsComputed())
+
+	for _, name := range []string{"token", "signup_url"} {
+		assert.True(t, schema.Attributes[name].IsComputed(), name)
+		assert.True(t, schema.Attributes[name].IsSensitive(), name)
+	}
+}
+
+func TestSignupTokenResource_Configure(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData interface{}
+		expectError  bool
+	}{
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:         "invalid provider data type",
+			providerData: "invalid",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewSignupTokenResource()
+
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.(resource.ResourceWithConfigure).Configure(context.TODO(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestSignupTokenResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	var createReq client.SignupTokenCreateRequest
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/signup-tokens", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&createReq))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "st-123",
+			"token": "abc123",
+			"usageLimit": 5,
+			"usageCount": 0,
+			"expiresAt": "2030-01-01T00:00:00Z",
+			"userGroups": [{"id": "group-1", "name": "staff", "friendlyName": "Staff"}],
+			"createdAt": "2029-12-29T00:00:00Z"
+		}`))
+	})
+
+	r := resources.NewSignupTokenResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"ttl":         tftypes.NewValue(tftypes.String, "72h"),
+			"usage_limit": tftypes.NewValue(tftypes.Number, 5),
+			"user_groups": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "group-1"),
+			}),
+			"token":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"signup_url":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"usage_count": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			"expires_at":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"created_at":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, "72h", createReq.TTL)
+	assert.Equal(t, int64(5), createReq.UsageLimit)
+	assert.Equal(t, []string{"group-1"}, createReq.UserGroupIDs)
+
+	var id, token, signupURL string
+	var usageCount int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("signup_url"), &signupURL)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("usage_count"), &usageCount)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "st-123", id)
+	assert.Equal(t, "abc123", token)
+	assert.Equal(t, testClient.SignupURL("abc123"), signupURL)
+	assert.Equal(t, int64(0), usageCount)
+}
+
+func TestSignupTokenResource_TTLReplacement(t *testing.T) {
+	ctx := context.Background()
+	r := resources.NewSignupTokenResource()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	ttlAttr, ok := schemaResp.Schema.Attributes["ttl"].(schema.StringAttribute)
+	require.True(t, ok)
+
+	testCases := []struct {
+		name         string
+		stateValue   types.String
+		wantReplaced bool
+	}{
+		{name: "changed", stateValue: types.StringValue("24h"), wantReplaced: true},
+		{name: "imported", stateValue: types.StringNull(), wantReplaced: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "st-123"),
+			})
+			req := planmodifier.StringRequest{
+				Path:       path.Root("ttl"),
+				State:      tfsdk.State{Schema: plan.Schema, Raw: plan.Raw},
+				Plan:       plan,
+				StateValue: tc.stateValue,
+				PlanValue:  types.StringValue("72h"),
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			for _, modifier := range ttlAttr.PlanModifiers {
+				modifier.PlanModifyString(ctx, req, resp)
+			}
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.wantReplaced, resp.RequiresReplace)
+		})
+	}
+}
+
+func TestSignupTokenResource_Update_RecordsImportedTTL(t *testing.T) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	r := resources.NewSignupTokenResource()
+	plan := configuredResource(t, r, testClient)
+	stateValue := func(ttl interface{}) tftypes.Value {
+		return objectValue(t, plan, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "st-123"),
+			"ttl":         tftypes.NewValue(tftypes.String, ttl),
+			"usage_limit": tftypes.NewValue(tftypes.Number, 1),
+			"token":       tftypes.NewValue(tftypes.String, "abc123"),
+		})
+	}
+	plan.Raw = stateValue("72h")
+
+	state := tfsdk.State{Schema: plan.Schema, Raw: stateValue(nil)}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var ttl, token string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "72h", ttl)
+	assert.Equal(t, "abc123", token, "the imported token must be kept")
+
+	// Any other update is not supported.
+	state.Raw = stateValue("24h")
+	resp = &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &testEmailResource{}
+	_ resource.ResourceWithConfigure = &testEmailResource{}
+)
+
+// NewTestEmailResource is a helper function to simplify the provider implementation.
+func NewTestEmailResource() resource.Resource {
+	return &testEmailResource{}
+}
+
+// testEmailResource defines the resource implementation.
+type testEmailResource struct {
+	client *client.Client
+}
+
+// testEmailResourceModel maps the resource schema data.
+type testEmailResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	SentAt   types.String `tfsdk:"sent_at"`
+}
+
+func (r *testEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_email"
+}
+
+func (r *testEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a test email from Pocket-ID to verify its SMTP configuration. The email goes to the " +
+			"user that owns the API key the provider authenticates with. This is an action resource: applying it sends " +
+			"the email, and changing `triggers` sends a new one (the resource is recreated). When Pocket-ID cannot send " +
+			"the email, the apply fails with the SMTP error reported by the server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the test email resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that sends a new test email when it changes. Typically " +
+					"wired to the SMTP configuration values so every change is verified immediately. If omitted, the " +
+					"email is sent only once (on create).",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of the most recent test email sent by this resource.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *testEmailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *testEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan testEmailResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "sending test email")
+	if err := r.client.SendTestEmail(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Error sending test email",
+			"Pocket-ID could not send the test email, check the SMTP configuration: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue("test-email")
+	plan.SentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *testEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A test email is an action with no readable server-side state; preserve prior state.
+	var data testEmailResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *testEmailResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes force replacement, so Update is never expected.
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"pocketid_test_email cannot be updated in place. Change the triggers map to send a new test email.",
+	)
+}
+
+func (r *testEmailResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A sent email cannot be recalled; there is nothing to delete server-side.
+	tflog.Trace(ctx, "removing pocketid_test_email from state (no server-side action)")
+}
@@ -0,0 +1,94 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewTestEmailResource(t *testing.T) {
+	r := resources.NewTestEmailResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithConfigure)(nil), r)
+}
+
+func TestTestEmailResource_Metadata(t *testing.T) {
+	r := resources.NewTestEmailResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.TODO(), resource.MetadataRequest{ProviderTypeName: "pocketid"}, resp)
+
+	assert.Equal(t, "pocketid_test_email", resp.TypeName)
+}
+
+func TestTestEmailResource_Schema(t *testing.T) {
+	r := resources.NewTestEmailResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["triggers"].IsOptional())
+	assert.False(t, schema.Attributes["triggers"].IsComputed())
+	assert.True(t, schema.Attributes["sent_at"].IsComputed())
+}
+
+func TestTestEmailResource_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		smtpError string
+	}{
+		{name: "sent"},
+		{name: "smtp error", smtpError: "failed to authenticate with SMTP server: 535 invalid credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/api/application-configuration/test-email", r.URL.Path)
+				if tt.smtpError == "" {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&client.ErrorResponse{Error: tt.smtpError})
+			})
+
+			r := resources.NewTestEmailResource()
+			plan := configuredResource(t, r, testClient)
+			plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"sent_at": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if tt.smtpError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.smtpError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var sentAt string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("sent_at"), &sentAt).HasError())
+			assert.NotEmpty(t, sentAt)
+		})
+	}
+}
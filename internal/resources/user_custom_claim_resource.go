@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// NewUserCustomClaimResource is a helper function to simplify the provider implementation.
+func NewUserCustomClaimResource() resource.Resource {
+	return &customClaimResource{subject: userCustomClaimSubject}
+}
+
+// userCustomClaimSubject makes a customClaimResource manage a custom claim of
+// a user.
+var userCustomClaimSubject = customClaimSubject{
+	typeName:    "_user_custom_claim",
+	attribute:   "user_id",
+	name:        "user",
+	description: "Manages a single custom claim of a user in Pocket-ID.",
+	markdownDescription: "Manages a single custom claim of a user in Pocket-ID. " +
+		"Unlike the `custom_claims` attribute of `pocketid_user`, this resource is not authoritative: " +
+		"it only sets and removes its own claim and leaves the user's other claims untouched, " +
+		"so it can be used for claims also edited in the Pocket-ID UI and for users that are not managed by Terraform, " +
+		"e.g. users synced from LDAP. " +
+		"Do not combine it with `custom_claims` on `pocketid_user` for the same user, as that attribute removes claims it does not list.",
+	attributeDescription: "The ID of the user. Changing this forces a new resource to be created.",
+
+	getClaims: func(c *client.Client, ctx context.Context, userID string) ([]client.CustomClaim, error) {
+		user, err := c.GetUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return user.CustomClaims, nil
+	},
+	setClaim:    (*client.Client).SetUserCustomClaim,
+	deleteClaim: (*client.Client).DeleteUserCustomClaim,
+}
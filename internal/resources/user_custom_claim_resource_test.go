@@ -0,0 +1,277 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewUserCustomClaimResource(t *testing.T) {
+	r := resources.NewUserCustomClaimResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithImportState)(nil), r)
+}
+
+func TestUserCustomClaimResource_Metadata(t *testing.T) {
+	r := resources.NewUserCustomClaimResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_user_custom_claim", resp.TypeName)
+}
+
+func TestUserCustomClaimResource_Schema(t *testing.T) {
+	r := resources.NewUserCustomClaimResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["key"].IsRequired())
+	assert.True(t, schema.Attributes["value"].IsRequired())
+}
+
+// customClaimState builds a state or plan value for a custom claim resource
+// whose subject is identified by subjectAttr.
+func customClaimState(t *testing.T, r resource.Resource, subjectAttr string, id any, subjectID, key, value string) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, id),
+		subjectAttr: tftypes.NewValue(tftypes.String, subjectID),
+		"key":       tftypes.NewValue(tftypes.String, key),
+		"value":     tftypes.NewValue(tftypes.String, value),
+	})
+
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: raw}, tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+}
+
+// userWithClaimsHandler serves a user with the given custom claims and records
+// the claims written back.
+func userWithClaimsHandler(t *testing.T, claims []client.CustomClaim, written *[]client.CustomClaim) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(client.User{ID: "user-123", CustomClaims: claims})
+		case "PUT":
+			assert.Equal(t, "/api/custom-claims/user/user-123", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(written))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(*written)
+		}
+	}
+}
+
+func TestUserCustomClaimResource_Create_KeepsOtherClaims(t *testing.T) {
+	ctx := context.Background()
+
+	var written []client.CustomClaim
+	testClient := createMockServer(t, userWithClaimsHandler(t, []client.CustomClaim{{Key: "team", Value: "platform"}}, &written))
+
+	r := resources.NewUserCustomClaimResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, plan := customClaimState(t, r, "user_id", tftypes.UnknownValue, "user-123", "role", "admin")
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []client.CustomClaim{{Key: "team", Value: "platform"}, {Key: "role", Value: "admin"}}, written)
+
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	assert.Equal(t, "user-123/role", id)
+}
+
+func TestUserCustomClaimResource_Read(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     []client.CustomClaim
+		wantRemove bool
+		wantValue  string
+	}{
+		{name: "unchanged", claims: []client.CustomClaim{{Key: "role", Value: "admin"}}, wantValue: "admin"},
+		{name: "changed outside of Terraform", claims: []client.CustomClaim{{Key: "role", Value: "viewer"}}, wantValue: "viewer"},
+		{name: "removed outside of Terraform", claims: []client.CustomClaim{{Key: "team", Value: "platform"}}, wantRemove: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var written []client.CustomClaim
+			testClient := createMockServer(t, userWithClaimsHandler(t, tt.claims, &written))
+
+			r := resources.NewUserCustomClaimResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			state, _ := customClaimState(t, r, "user_id", "user-123/role", "user-123", "role", "admin")
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantRemove, resp.State.Raw.IsNull())
+			assert.Nil(t, written, "Read must not change claims")
+			if tt.wantRemove {
+				return
+			}
+
+			var value string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("value"), &value)...)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+func TestUserCustomClaimResource_Update(t *testing.T) {
+	ctx := context.Background()
+
+	var written []client.CustomClaim
+	testClient := createMockServer(t, userWithClaimsHandler(t, []client.CustomClaim{
+		{Key: "role", Value: "viewer"},
+		{Key: "team", Value: "platform"},
+	}, &written))
+
+	r := resources.NewUserCustomClaimResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, _ := customClaimState(t, r, "user_id", "user-123/role", "user-123", "role", "viewer")
+	_, plan := customClaimState(t, r, "user_id", "user-123/role", "user-123", "role", "admin")
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []client.CustomClaim{{Key: "role", Value: "admin"}, {Key: "team", Value: "platform"}}, written)
+}
+
+func TestUserCustomClaimResource_Delete_KeepsOtherClaims(t *testing.T) {
+	ctx := context.Background()
+
+	var written []client.CustomClaim
+	testClient := createMockServer(t, userWithClaimsHandler(t, []client.CustomClaim{
+		{Key: "role", Value: "admin"},
+		{Key: "team", Value: "platform"},
+	}, &written))
+
+	r := resources.NewUserCustomClaimResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, _ := customClaimState(t, r, "user_id", "user-123/role", "user-123", "role", "admin")
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []client.CustomClaim{{Key: "team", Value: "platform"}}, written)
+}
+
+func TestUserCustomClaimResource_ImportState(t *testing.T) {
+	tests := []struct {
+		id          string
+		expectError bool
+	}{
+		{id: "user-123/role"},
+		{id: "user-123", expectError: true},
+		{id: "user-123/", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			ctx := context.Background()
+			r := resources.NewUserCustomClaimResource()
+			state, _ := customClaimState(t, r, "user_id", nil, "", "", "")
+			state.Raw = tftypes.NewValue(state.Raw.Type(), nil)
+
+			resp := &resource.ImportStateResponse{State: state}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				return
+			}
+
+			var userID, key string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("user_id"), &userID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("key"), &key)...)
+			assert.Equal(t, "user-123", userID)
+			assert.Equal(t, "role", key)
+		})
+	}
+}
+
+// A pocketid_user without a custom_claims attribute must not pick up claims
+// managed by pocketid_user_custom_claim, while an imported user must.
+func TestUserResource_Read_UnmanagedCustomClaims(t *testing.T) {
+	tests := []struct {
+		name       string
+		imported   bool
+		wantClaims map[string]string
+	}{
+		{name: "custom claims not configured", imported: false},
+		{name: "imported", imported: true, wantClaims: map[string]string{"role": "admin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(client.User{
+					ID:           "user-123",
+					Username:     "jane",
+					Email:        "jane@example.com",
+					CustomClaims: []client.CustomClaim{{Key: "role", Value: "admin"}},
+				})
+			})
+
+			r := resources.NewUserResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+			if tt.imported {
+				importResp := &resource.ImportStateResponse{State: state}
+				r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "user-123"}, importResp)
+				require.False(t, importResp.Diagnostics.HasError(), "unexpected diagnostics: %v", importResp.Diagnostics)
+				state = importResp.State
+			} else {
+				require.False(t, state.SetAttribute(ctx, path.Root("id"), "user-123").HasError())
+			}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var claims map[string]string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("custom_claims"), &claims).HasError())
+			assert.Equal(t, tt.wantClaims, claims)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &userGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &userGroupMembershipResource{}
+)
+
+// NewUserGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewUserGroupMembershipResource() resource.Resource {
+	return &userGroupMembershipResource{}
+}
+
+// userGroupMembershipResource is the resource implementation.
+type userGroupMembershipResource struct {
+	client *client.Client
+}
+
+// userGroupMembershipResourceModel maps the resource schema data.
+type userGroupMembershipResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	UserID  types.String `tfsdk:"user_id"`
+	GroupID types.String `tfsdk:"group_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *userGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *userGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a single user in a single group in Pocket-ID.",
+		MarkdownDescription: "Manages the membership of a single user in a single group in Pocket-ID. " +
+			"Unlike the `groups` attribute of `pocketid_user`, this resource is not authoritative: " +
+			"it only adds and removes its own membership and leaves the user's other groups untouched, " +
+			"so different configurations can each add the same user to their own groups. " +
+			"Do not combine it with `groups` on `pocketid_user` for the same user or `members` on `pocketid_group` for the same group, " +
+			"as those attributes remove memberships they do not list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the membership in the form <user_id>/<group_id>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// Create adds the user to the group and sets the initial Terraform state.
+func (r *userGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Adding user to group", map[string]any{
+		"user_id":  plan.UserID.ValueString(),
+		"group_id": plan.GroupID.ValueString(),
+	})
+
+	if err := r.client.AddUserToGroup(ctx, plan.UserID.ValueString(), plan.GroupID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user group membership",
+			"Could not add user "+plan.UserID.ValueString()+" to group "+plan.GroupID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.UserID.ValueString() + "/" + plan.GroupID.ValueString())
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read checks that the user is still a member of the group.
+func (r *userGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.UserID.ValueString()
+	groupID := state.GroupID.ValueString()
+	tflog.Debug(ctx, "Reading user group membership", map[string]any{
+		"user_id":  userID,
+		"group_id": groupID,
+	})
+
+	user, err := r.client.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "User not found, removing group membership from state", map[string]any{
+				"user_id": userID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading user group membership",
+			"Could not read user "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	isMember := false
+	for _, group := range user.UserGroups {
+		if group.ID == groupID {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		tflog.Warn(ctx, "User is no longer a member of the group, removing membership from state", map[string]any{
+			"user_id":  userID,
+			"group_id": groupID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(userID + "/" + groupID)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every argument forces replacement.
+func (r *userGroupMembershipResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"User group memberships cannot be updated. To change a membership, delete and recreate it.",
+	)
+}
+
+// Delete removes the user from the group and removes the Terraform state on success.
+func (r *userGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing user from group", map[string]any{
+		"user_id":  state.UserID.ValueString(),
+		"group_id": state.GroupID.ValueString(),
+	})
+
+	err := r.client.RemoveUserFromGroup(ctx, state.UserID.ValueString(), state.GroupID.ValueString())
+	// A user that no longer exists is no longer a member of any group.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting user group membership",
+			"Could not remove user from group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing membership by an ID in the form
+// <user_id>/<group_id>.
+func (r *userGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, groupID, ok := strings.Cut(req.ID, "/")
+	if !ok || userID == "" || groupID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form <user_id>/<group_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+}
@@ -0,0 +1,246 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewUserGroupMembershipResource(t *testing.T) {
+	r := resources.NewUserGroupMembershipResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithImportState)(nil), r)
+}
+
+func TestUserGroupMembershipResource_Metadata(t *testing.T) {
+	r := resources.NewUserGroupMembershipResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_user_group_membership", resp.TypeName)
+}
+
+func TestUserGroupMembershipResource_Schema(t *testing.T) {
+	r := resources.NewUserGroupMembershipResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["group_id"].IsRequired())
+}
+
+// membershipState builds a state or plan value for the membership resource.
+func membershipState(t *testing.T, r resource.Resource, id any, userID, groupID string) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, id),
+		"user_id":  tftypes.NewValue(tftypes.String, userID),
+		"group_id": tftypes.NewValue(tftypes.String, groupID),
+	})
+
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: raw}, tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+}
+
+// userWithGroupsHandler serves a user in the given groups and records the
+// group IDs written back.
+func userWithGroupsHandler(t *testing.T, groupIDs []string, written *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			user := client.User{ID: "user-123"}
+			for _, id := range groupIDs {
+				user.UserGroups = append(user.UserGroups, client.UserGroup{ID: id})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(user)
+		case "PUT":
+			assert.Equal(t, "/api/users/user-123/user-groups", r.URL.Path)
+			var req client.UpdateUserGroupsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			*written = req.UserGroupIDs
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+}
+
+func TestUserGroupMembershipResource_Create_KeepsOtherGroups(t *testing.T) {
+	ctx := context.Background()
+
+	var written []string
+	testClient := createMockServer(t, userWithGroupsHandler(t, []string{"group-other"}, &written))
+
+	r := resources.NewUserGroupMembershipResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, plan := membershipState(t, r, tftypes.UnknownValue, "user-123", "group-123")
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"group-other", "group-123"}, written)
+
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	assert.Equal(t, "user-123/group-123", id)
+}
+
+func TestUserGroupMembershipResource_Read(t *testing.T) {
+	tests := []struct {
+		name       string
+		groupIDs   []string
+		wantRemove bool
+	}{
+		{name: "member", groupIDs: []string{"group-other", "group-123"}},
+		{name: "removed outside of Terraform", groupIDs: []string{"group-other"}, wantRemove: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var written []string
+			testClient := createMockServer(t, userWithGroupsHandler(t, tt.groupIDs, &written))
+
+			r := resources.NewUserGroupMembershipResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			state, _ := membershipState(t, r, "user-123/group-123", "user-123", "group-123")
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantRemove, resp.State.Raw.IsNull())
+			assert.Nil(t, written, "Read must not change memberships")
+		})
+	}
+}
+
+func TestUserGroupMembershipResource_Delete_KeepsOtherGroups(t *testing.T) {
+	ctx := context.Background()
+
+	var written []string
+	testClient := createMockServer(t, userWithGroupsHandler(t, []string{"group-other", "group-123"}, &written))
+
+	r := resources.NewUserGroupMembershipResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, _ := membershipState(t, r, "user-123/group-123", "user-123", "group-123")
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, []string{"group-other"}, written)
+}
+
+func TestUserGroupMembershipResource_ImportState(t *testing.T) {
+	tests := []struct {
+		id          string
+		expectError bool
+	}{
+		{id: "user-123/group-123"},
+		{id: "user-123", expectError: true},
+		{id: "/group-123", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			ctx := context.Background()
+			r := resources.NewUserGroupMembershipResource()
+			state, _ := membershipState(t, r, nil, "", "")
+			state.Raw = tftypes.NewValue(state.Raw.Type(), nil)
+
+			resp := &resource.ImportStateResponse{State: state}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				return
+			}
+
+			var userID, groupID string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("user_id"), &userID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("group_id"), &groupID)...)
+			assert.Equal(t, "user-123", userID)
+			assert.Equal(t, "group-123", groupID)
+		})
+	}
+}
+
+// A pocketid_user without a groups attribute must not pick up memberships
+// managed by pocketid_user_group_membership, while an imported user must.
+func TestUserResource_Read_UnmanagedGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		imported   bool
+		wantGroups []string
+	}{
+		{name: "groups not configured", imported: false},
+		{name: "imported", imported: true, wantGroups: []string{"group-123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(client.User{
+					ID:         "user-123",
+					Username:   "jane",
+					Email:      "jane@example.com",
+					UserGroups: []client.UserGroup{{ID: "group-123"}},
+				})
+			})
+
+			r := resources.NewUserResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+			if tt.imported {
+				importResp := &resource.ImportStateResponse{State: state}
+				r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "user-123"}, importResp)
+				require.False(t, importResp.Diagnostics.HasError(), "unexpected diagnostics: %v", importResp.Diagnostics)
+				state = importResp.State
+			} else {
+				require.False(t, state.SetAttribute(ctx, path.Root("id"), "user-123").HasError())
+			}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var groups []string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("groups"), &groups).HasError())
+			assert.Equal(t, tt.wantGroups, groups)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &userPasskeyRevocationResource{}
+	_ resource.ResourceWithConfigure = &userPasskeyRevocationResource{}
+)
+
+// NewUserPasskeyRevocationResource is a helper function to simplify the provider implementation.
+func NewUserPasskeyRevocationResource() resource.Resource {
+	return &userPasskeyRevocationResource{}
+}
+
+// userPasskeyRevocationResource defines the resource implementation.
+type userPasskeyRevocationResource struct {
+	client *client.Client
+}
+
+// userPasskeyRevocationResourceModel maps the resource schema data.
+type userPasskeyRevocationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	UserID            types.String `tfsdk:"user_id"`
+	PasskeyIDs        types.Set    `tfsdk:"passkey_ids"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	RevokedPasskeyIDs types.Set    `tfsdk:"revoked_passkey_ids"`
+	RevokedAt         types.String `tfsdk:"revoked_at"`
+}
+
+func (r *userPasskeyRevocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_passkey_revocation"
+}
+
+func (r *userPasskeyRevocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Revokes passkeys of a user in Pocket-ID, e.g. a lost passkey or all passkeys of a leaver. " +
+			"This is an action resource: applying it revokes the passkeys, and changing any argument revokes them " +
+			"again (the resource is recreated). Revoked passkeys cannot be restored, so destroying the resource only " +
+			"removes it from the state. Use the `pocketid_user_passkeys` data source to look up passkey IDs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the revocation, identical to `user_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user whose passkeys are revoked.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"passkey_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the passkeys to revoke. If omitted, all passkeys of the user are revoked. " +
+					"IDs of passkeys that no longer exist are skipped with a warning.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that revokes the passkeys again when it changes, e.g. to " +
+					"revoke passkeys the user registered since the last apply. If omitted, the passkeys are revoked " +
+					"only once (on create).",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"revoked_passkey_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the passkeys revoked by this resource.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"revoked_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp (RFC3339) of the most recent revocation performed by this resource.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *userPasskeyRevocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *userPasskeyRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userPasskeyRevocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+
+	var requested []string
+	if !plan.PasskeyIDs.IsNull() {
+		resp.Diagnostics.Append(plan.PasskeyIDs.ElementsAs(ctx, &requested, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	credentials, err := r.client.ListUserWebauthnCredentials(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking passkeys",
+			"Could not list passkeys of user "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Without passkey_ids every passkey is revoked.
+	toRevoke := make([]string, 0, len(credentials))
+	if requested == nil {
+		for _, credential := range credentials {
+			toRevoke = append(toRevoke, credential.ID)
+		}
+	} else {
+		existing := make(map[string]bool, len(credentials))
+		for _, credential := range credentials {
+			existing[credential.ID] = true
+		}
+		for _, id := range requested {
+			if !existing[id] {
+				resp.Diagnostics.AddWarning(
+					"Passkey Not Found",
+					fmt.Sprintf("User %s has no passkey with ID %s. It may have been revoked already, so it is skipped.", userID, id),
+				)
+				continue
+			}
+			toRevoke = append(toRevoke, id)
+		}
+	}
+
+	tflog.Debug(ctx, "revoking user passkeys", map[string]any{
+		"user_id": userID,
+		"count":   len(toRevoke),
+	})
+
+	revoked := make([]string, 0, len(toRevoke))
+	for _, id := range toRevoke {
+		err := r.client.DeleteUserWebauthnCredential(ctx, userID, id)
+		// A passkey deleted in the meantime is revoked as well.
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error revoking passkeys",
+				fmt.Sprintf("Could not revoke passkey %s of user %s: %s", id, userID, err.Error()),
+			)
+			return
+		}
+		revoked = append(revoked, id)
+	}
+
+	revokedIDs, diags := types.SetValueFrom(ctx, types.StringType, revoked)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.UserID
+	plan.RevokedPasskeyIDs = revokedIDs
+	plan.RevokedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userPasskeyRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A revocation is an action with no readable server-side state; preserve prior state.
+	var data userPasskeyRevocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userPasskeyRevocationResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes force replacement, so Update is never expected.
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"pocketid_user_passkey_revocation cannot be updated in place. Change the triggers map to revoke passkeys again.",
+	)
+}
+
+func (r *userPasskeyRevocationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Revoked passkeys cannot be restored; there is nothing to delete server-side.
+	tflog.Trace(ctx, "removing pocketid_user_passkey_revocation from state (no server-side action)")
+}
@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewUserPasskeyRevocationResource(t *testing.T) {
+	r := resources.NewUserPasskeyRevocationResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.ResourceWithConfigure)(nil), r)
+}
+
+func TestUserPasskeyRevocationResource_Metadata(t *testing.T) {
+	r := resources.NewUserPasskeyRevocationResource()
+
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.TODO(), resource.MetadataRequest{ProviderTypeName: "pocketid"}, resp)
+
+	assert.Equal(t, "pocketid_user_passkey_revocation", resp.TypeName)
+}
+
+func TestUserPasskeyRevocationResource_Schema(t *testing.T) {
+	r := resources.NewUserPasskeyRevocationResource()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.TODO(), resource.SchemaRequest{}, resp)
+
+	schema := resp.Schema
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["passkey_ids"].IsOptional())
+	assert.True(t, schema.Attributes["triggers"].IsOptional())
+	assert.True(t, schema.Attributes["revoked_passkey_ids"].IsComputed())
+	assert.True(t, schema.Attributes["revoked_at"].IsComputed())
+}
+
+func TestUserPasskeyRevocationResource_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		passkeyIDs  tftypes.Value
+		wantRevoked []string
+		wantWarning bool
+	}{
+		{
+			name:        "all passkeys",
+			passkeyIDs:  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			wantRevoked: []string{"cred-1", "cred-2"},
+		},
+		{
+			name:        "specific passkeys",
+			passkeyIDs:  stringSet("cred-2"),
+			wantRevoked: []string{"cred-2"},
+		},
+		{
+			name:        "missing passkey",
+			passkeyIDs:  stringSet("cred-2", "cred-gone"),
+			wantRevoked: []string{"cred-2"},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var deleted []string
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					assert.Equal(t, "/api/users/user-123/webauthn-credentials", r.URL.Path)
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`[{"id": "cred-1", "name": "Laptop"}, {"id": "cred-2", "name": "Phone"}]`))
+				case "DELETE":
+					deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/users/user-123/webauthn-credentials/"))
+					w.WriteHeader(http.StatusNoContent)
+				}
+			})
+
+			r := resources.NewUserPasskeyRevocationResource()
+			plan := configuredResource(t, r, testClient)
+			plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+				"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"user_id":             tftypes.NewValue(tftypes.String, "user-123"),
+				"passkey_ids":         tt.passkeyIDs,
+				"revoked_passkey_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"revoked_at":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.wantWarning, resp.Diagnostics.WarningsCount() > 0)
+
+			assert.Equal(t, tt.wantRevoked, deleted)
+
+			var revoked []string
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("revoked_passkey_ids"), &revoked).HasError())
+			assert.ElementsMatch(t, tt.wantRevoked, revoked)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &userProfilePictureResource{}
+	_ resource.ResourceWithConfigure        = &userProfilePictureResource{}
+	_ resource.ResourceWithModifyPlan       = &userProfilePictureResource{}
+	_ resource.ResourceWithConfigValidators = &userProfilePictureResource{}
+)
+
+// NewUserProfilePictureResource is a helper function to simplify the provider implementation.
+func NewUserProfilePictureResource() resource.Resource {
+	return &userProfilePictureResource{}
+}
+
+// userProfilePictureResource is the resource implementation.
+type userProfilePictureResource struct {
+	client *client.Client
+}
+
+// userProfilePictureResourceModel maps the resource schema data.
+type userProfilePictureResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	UserID               types.String `tfsdk:"user_id"`
+	ProfilePictureFile   types.String `tfsdk:"profile_picture_file"`
+	ProfilePictureBase64 types.String `tfsdk:"profile_picture_base64"`
+	ProfilePictureSHA256 types.String `tfsdk:"profile_picture_sha256"`
+	ServedSHA256         types.String `tfsdk:"served_sha256"`
+}
+
+// Metadata returns the resource type name.
+func (r *userProfilePictureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_profile_picture"
+}
+
+// Schema defines the schema for the resource.
+func (r *userProfilePictureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the profile picture of a user in Pocket-ID.",
+		MarkdownDescription: "Manages the profile picture of a user in Pocket-ID. " +
+			"The picture is set either from a file or from base64-encoded content. " +
+			"Pocket-ID converts uploaded pictures to PNG, so the hash of the picture it serves is recorded after each upload; " +
+			"a picture changed or reset outside of Terraform no longer matches it and is uploaded again. " +
+			"Destroying the resource resets the user to the picture generated from their initials.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user, identical to user_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile_picture_file": schema.StringAttribute{
+				Description: "Path to the profile picture. The file extension determines the image type. " +
+					"Exactly one of profile_picture_file and profile_picture_base64 must be set.",
+				Optional: true,
+			},
+			"profile_picture_base64": schema.StringAttribute{
+				Description: "Base64-encoded profile picture, e.g. from filebase64(). " +
+					"Exactly one of profile_picture_file and profile_picture_base64 must be set.",
+				Optional: true,
+			},
+			"profile_picture_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the configured profile picture.",
+				Computed:    true,
+			},
+			"served_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the profile picture as served by Pocket-ID after the last upload.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one source of the picture.
+func (r *userProfilePictureResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("profile_picture_file"),
+			path.MatchRoot("profile_picture_base64"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userProfilePictureResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans the hash of the configured picture. The served hash is only
+// known after an upload, so it becomes unknown whenever the picture changes.
+func (r *userProfilePictureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan userProfilePictureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state userProfilePictureResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ProfilePictureSHA256 = plannedImageHash("profile_picture", plan.ProfilePictureFile, plan.ProfilePictureBase64, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ServedSHA256 = state.ServedSHA256
+	if req.State.Raw.IsNull() || !plan.ProfilePictureSHA256.Equal(state.ProfilePictureSHA256) {
+		plan.ServedSHA256 = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create uploads the profile picture and sets the initial Terraform state.
+func (r *userProfilePictureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userProfilePictureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Uploading user profile picture", map[string]any{
+		"user_id": plan.UserID.ValueString(),
+	})
+
+	if err := r.upload(ctx, &plan, types.StringNull()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading user profile picture",
+			"Could not upload profile picture for user "+plan.UserID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read compares the picture served by Pocket-ID with the one recorded after
+// the last upload.
+func (r *userProfilePictureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userProfilePictureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.UserID.ValueString()
+	tflog.Debug(ctx, "Reading user profile picture", map[string]any{
+		"user_id": userID,
+	})
+
+	picture, err := r.client.GetUserProfilePicture(ctx, userID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "User not found, removing profile picture from state", map[string]any{
+				"user_id": userID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading user profile picture",
+			"Could not download profile picture for user "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	// The picture was replaced or reset outside of Terraform. Clearing the
+	// hash makes the next plan upload the configured picture again.
+	if served := contentHash(picture); served != state.ServedSHA256.ValueString() {
+		tflog.Warn(ctx, "User profile picture changed outside of Terraform", map[string]any{
+			"user_id": userID,
+		})
+		state.ProfilePictureSHA256 = types.StringNull()
+		state.ServedSHA256 = types.StringValue(served)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update uploads the profile picture again.
+func (r *userProfilePictureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userProfilePictureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating user profile picture", map[string]any{
+		"user_id": plan.UserID.ValueString(),
+	})
+
+	if err := r.upload(ctx, &plan, state.ProfilePictureSHA256); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating user profile picture",
+			"Could not upload profile picture for user "+plan.UserID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete resets the profile picture and removes the Terraform state on success.
+func (r *userProfilePictureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userProfilePictureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Resetting user profile picture", map[string]any{
+		"user_id": state.UserID.ValueString(),
+	})
+
+	err := r.client.ResetUserProfilePicture(ctx, state.UserID.ValueString())
+	// A user that no longer exists has no profile picture to reset.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error resetting user profile picture",
+			"Could not reset profile picture, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// upload uploads the configured picture when its hash differs from current
+// and records the hash of the picture Pocket-ID serves afterwards.
+func (r *userProfilePictureResource) upload(ctx context.Context, plan *userProfilePictureResourceModel, current types.String) error {
+	userID := plan.UserID.ValueString()
+
+	hash, err := syncImage(plan.ProfilePictureFile, plan.ProfilePictureBase64, current,
+		func(fileName string, content []byte) error {
+			return r.client.UploadUserProfilePicture(ctx, userID, fileName, content)
+		},
+		func() error {
+			return r.client.ResetUserProfilePicture(ctx, userID)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	picture, err := r.client.GetUserProfilePicture(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error downloading the uploaded picture: %w", err)
+	}
+
+	plan.ID = plan.UserID
+	plan.ProfilePictureSHA256 = hash
+	plan.ServedSHA256 = types.StringValue(contentHash(picture))
+	return nil
+}
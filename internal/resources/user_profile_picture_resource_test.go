@@ -0,0 +1,197 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+func TestNewUserProfilePictureResource(t *testing.T) {
+	r := resources.NewUserProfilePictureResource()
+	assert.NotNil(t, r)
+	assert.Implements(t, (*resource.Resource)(nil), r)
+}
+
+func TestUserProfilePictureResource_Metadata(t *testing.T) {
+	r := resources.NewUserProfilePictureResource()
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "pocketid",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.TODO(), req, resp)
+
+	assert.Equal(t, "pocketid_user_profile_picture", resp.TypeName)
+}
+
+func TestUserProfilePictureResource_Schema(t *testing.T) {
+	r := resources.NewUserProfilePictureResource()
+
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.TODO(), req, resp)
+
+	schema := resp.Schema
+	assert.NotNil(t, schema)
+
+	assert.True(t, schema.Attributes["id"].IsComputed())
+	assert.True(t, schema.Attributes["user_id"].IsRequired())
+	assert.True(t, schema.Attributes["profile_picture_file"].IsOptional())
+	assert.True(t, schema.Attributes["profile_picture_base64"].IsOptional())
+	assert.True(t, schema.Attributes["profile_picture_sha256"].IsComputed())
+	assert.True(t, schema.Attributes["served_sha256"].IsComputed())
+}
+
+func TestUserProfilePictureResource_Configure(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData interface{}
+		expectError  bool
+	}{
+		{
+			name:         "nil provider data",
+			providerData: nil,
+			expectError:  false,
+		},
+		{
+			name:         "invalid provider data type",
+			providerData: "invalid",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewUserProfilePictureResource()
+
+			req := resource.ConfigureRequest{
+				ProviderData: tt.providerData,
+			}
+			resp := &resource.ConfigureResponse{}
+
+			r.(resource.ResourceWithConfigure).Configure(context.TODO(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+// profilePictureValue builds a pocketid_user_profile_picture object with the
+// picture set from base64 content and the given hashes.
+func profilePictureValue(ctx context.Context, t *testing.T, r resource.Resource, picture []byte, pictureHash, servedHash interface{}) (tfsdk.State, tftypes.Value) {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                     tftypes.NewValue(tftypes.String, "user-123"),
+		"user_id":                tftypes.NewValue(tftypes.String, "user-123"),
+		"profile_picture_file":   tftypes.NewValue(tftypes.String, nil),
+		"profile_picture_base64": tftypes.NewValue(tftypes.String, base64.StdEncoding.EncodeToString(picture)),
+		"profile_picture_sha256": tftypes.NewValue(tftypes.String, pictureHash),
+		"served_sha256":          tftypes.NewValue(tftypes.String, servedHash),
+	})
+	return tfsdk.State{Schema: schemaResp.Schema}, value
+}
+
+func TestUserProfilePictureResource_Create(t *testing.T) {
+	ctx := context.Background()
+	picture := []byte("\xff\xd8\xff\xe0jpeg")
+	served := []byte("converted-png")
+
+	var uploadedName string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /api/users/user-123/profile-picture":
+			_, header, err := r.FormFile("file")
+			require.NoError(t, err)
+			uploadedName = header.Filename
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/users/user-123/profile-picture.png":
+			_, _ = w.Write(served)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := resources.NewUserProfilePictureResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+	state, value := profilePictureValue(ctx, t, r, picture, sha256Hex(picture), tftypes.UnknownValue)
+	resp := &resource.CreateResponse{State: state}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: value}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	assert.Equal(t, "image.jpg", uploadedName)
+
+	var pictureHash, servedHash string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("profile_picture_sha256"), &pictureHash)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("served_sha256"), &servedHash)...)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, sha256Hex(picture), pictureHash)
+	assert.Equal(t, sha256Hex(served), servedHash, "the hash of the converted picture should be recorded")
+}
+
+func TestUserProfilePictureResource_Read(t *testing.T) {
+	picture := []byte("\xff\xd8\xff\xe0jpeg")
+
+	tests := []struct {
+		name        string
+		served      []byte
+		wantPicture *string
+	}{
+		{
+			name:        "unchanged",
+			served:      []byte("converted-png"),
+			wantPicture: func() *string { h := sha256Hex(picture); return &h }(),
+		},
+		{
+			name:        "changed outside of Terraform",
+			served:      []byte("initials-png"),
+			wantPicture: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/users/user-123/profile-picture.png", r.URL.Path)
+				_, _ = w.Write(tt.served)
+			})
+
+			r := resources.NewUserProfilePictureResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: testClient}, &resource.ConfigureResponse{})
+
+			state, value := profilePictureValue(ctx, t, r, picture, sha256Hex(picture), sha256Hex([]byte("converted-png")))
+			state.Raw = value
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var pictureHash *string
+			var servedHash string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("profile_picture_sha256"), &pictureHash)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("served_sha256"), &servedHash)...)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantPicture, pictureHash)
+			assert.Equal(t, sha256Hex(tt.served), servedHash)
+		})
+	}
+}
@@ -2,8 +2,10 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +26,7 @@
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 // NewUserResource is a helper function to simplify the provider implementation.
@@ -122,13 +125,13 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Default:     booldefault.StaticBool(false),
 			},
 			"groups": schema.SetAttribute{
-				Description: "List of group IDs the user belongs to.",
+				Description: "List of group IDs the user belongs to. Setting this attribute replaces all group memberships of the user; when it is not set, memberships are left unmanaged, e.g. for pocketid_user_group_membership. Removing the attribute keeps the current memberships; set it to an empty set to remove them all. A plan warns, on a best-effort basis, when it disagrees with the members of a pocketid_group about a membership.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
 			"custom_claims": schema.MapAttribute{
 				Description:         "Custom claims to include in the user's OIDC tokens, as a map of claim name to value. Reserved claim names (e.g. 'email', 'groups', 'sub') are rejected by Pocket-ID.",
-				MarkdownDescription: "Custom claims to include in the user's OIDC tokens, as a map of claim name to value. Setting this attribute replaces all custom claims for the user. Reserved claim names (e.g. `email`, `groups`, `sub`) are rejected by Pocket-ID.",
+				MarkdownDescription: "Custom claims to include in the user's OIDC tokens, as a map of claim name to value. Setting this attribute replaces all custom claims for the user; when it is not set, custom claims are left unmanaged, e.g. for `pocketid_user_custom_claim`. Removing the attribute keeps the current custom claims; set it to an empty map to remove them all. Reserved claim names (e.g. `email`, `groups`, `sub`) are rejected by Pocket-ID.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
@@ -154,6 +157,40 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+// ModifyPlan warns when the configured groups disagree with the members
+// configured on a pocketid_group.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed or the provider
+	// is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var id types.String
+	var groups types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("groups"), &groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can only be compared once the user exists and its groups
+	// are managed and known.
+	if id.IsUnknown() || groups.IsNull() || groups.IsUnknown() {
+		return
+	}
+
+	groupIDs, diags := knownIDs(ctx, groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, groupID := range r.client.MembershipClaims().ClaimUserGroups(id.ValueString(), groupIDs) {
+		addMembershipConflictWarning(&resp.Diagnostics, path.Root("groups"), id.ValueString(), groupID, slices.Contains(groupIDs, groupID))
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -202,7 +239,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"isAdmin":  createReq.IsAdmin,
 	})
 
-	userResp, err := r.client.CreateUser(createReq)
+	userResp, err := r.client.CreateUser(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating user",
@@ -246,10 +283,10 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 			tflog.Debug(ctx, "Updating user groups", map[string]any{
 				"groups": groupIDs,
 			})
-			err = r.client.UpdateUserGroups(userResp.ID, groupIDs)
+			err = r.client.UpdateUserGroups(ctx, userResp.ID, groupIDs)
 			if err != nil {
 				// Try to clean up the created user
-				_ = r.client.DeleteUser(userResp.ID)
+				_ = r.client.DeleteUser(ctx, userResp.ID)
 				resp.Diagnostics.AddError(
 					"Error updating user groups",
 					"Could not update user groups, the user was deleted. Error: "+err.Error(),
@@ -270,17 +307,17 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 			tflog.Debug(ctx, "Updating user custom claims", map[string]any{
 				"id": userResp.ID,
 			})
-			updatedClaims, err := r.client.UpdateUserCustomClaims(userResp.ID, claims)
+			updatedClaims, err := r.client.UpdateUserCustomClaims(ctx, userResp.ID, claims)
 			if err != nil {
 				// Try to clean up the created user
-				_ = r.client.DeleteUser(userResp.ID)
+				_ = r.client.DeleteUser(ctx, userResp.ID)
 				resp.Diagnostics.AddError(
 					"Error updating user custom claims",
 					"Could not update user custom claims, the user was deleted. Error: "+err.Error(),
 				)
 				return
 			}
-			claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims)
+			claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims, isManaged(plan.CustomClaims))
 			resp.Diagnostics.Append(claimDiags...)
 			if resp.Diagnostics.HasError() {
 				return
@@ -309,8 +346,17 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	})
 
 	// Get user from API
-	userResp, err := r.client.GetUser(state.ID.ValueString())
+	userResp, err := r.client.GetUser(ctx, state.ID.ValueString())
 	if err != nil {
+		// The object was deleted outside of Terraform; drop it from state so
+		// the next plan recreates it.
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Warn(ctx, "User not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading user",
 			"Could not read user ID "+state.ID.ValueString()+": "+err.Error(),
@@ -335,26 +381,36 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Locale = types.StringNull()
 	}
 
-	// Update groups
-	if len(userResp.UserGroups) > 0 {
-		var groupIDs []string
+	// Update groups while they are managed, see isManaged.
+	if !isManaged(state.Groups) {
+		tflog.Debug(ctx, "User groups are not managed, skipping refresh", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+	} else {
+		// A managed attribute stays an empty set when the user is in no
+		// group, as null would stop managing it.
+		groupIDs := []string{}
 		for _, group := range userResp.UserGroups {
 			groupIDs = append(groupIDs, group.ID)
 		}
 		groups, diags := types.SetValueFrom(ctx, types.StringType, groupIDs)
 		resp.Diagnostics.Append(diags...)
 		state.Groups = groups
-	} else {
-		state.Groups = types.SetNull(types.StringType)
 	}
 
-	// Update custom claims
-	claimsMap, claimDiags := customClaimsToState(ctx, userResp.CustomClaims)
-	resp.Diagnostics.Append(claimDiags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Update custom claims while they are managed, see isManaged.
+	if !isManaged(state.CustomClaims) {
+		tflog.Debug(ctx, "Custom claims are not managed, skipping refresh", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+	} else {
+		claimsMap, claimDiags := customClaimsToState(ctx, userResp.CustomClaims, isManaged(state.CustomClaims))
+		resp.Diagnostics.Append(claimDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.CustomClaims = claimsMap
 	}
-	state.CustomClaims = claimsMap
 
 	// Set the state
 	diags = resp.State.Set(ctx, &state)
@@ -415,7 +471,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		"email":    updateReq.Email,
 	})
 
-	userResp, err := r.client.UpdateUser(plan.ID.ValueString(), updateReq)
+	userResp, err := r.client.UpdateUser(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating user",
@@ -441,7 +497,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		plan.Locale = types.StringNull()
 	}
 
-	// Handle user groups
+	// Handle user groups while they are managed, see isManaged.
 	var plannedGroupIDs []string
 	if !plan.Groups.IsNull() && !plan.Groups.IsUnknown() {
 		diags = plan.Groups.ElementsAs(ctx, &plannedGroupIDs, false)
@@ -454,7 +510,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		resp.Diagnostics.Append(diags...)
 	}
 
-	if !resp.Diagnostics.HasError() {
+	if isManaged(plan.Groups) && !resp.Diagnostics.HasError() {
 		// Check if groups have changed
 		groupsChanged := false
 		if len(plannedGroupIDs) != len(currentGroupIDs) {
@@ -477,7 +533,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			tflog.Debug(ctx, "Updating user groups", map[string]any{
 				"groups": plannedGroupIDs,
 			})
-			err = r.client.UpdateUserGroups(plan.ID.ValueString(), plannedGroupIDs)
+			err = r.client.UpdateUserGroups(ctx, plan.ID.ValueString(), plannedGroupIDs)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error updating user groups",
@@ -488,9 +544,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	// Handle custom claims. The API performs a full replace, so any change to the
-	// map (including clearing it) is applied by sending the full desired list.
-	if !plan.CustomClaims.Equal(state.CustomClaims) {
+	// Handle custom claims while they are managed, see isManaged. The API
+	// performs a full replace, so any change to the map is applied by sending
+	// the full desired list.
+	if isManaged(plan.CustomClaims) && !plan.CustomClaims.Equal(state.CustomClaims) {
 		claims, claimDiags := customClaimsToAPI(ctx, plan.CustomClaims)
 		resp.Diagnostics.Append(claimDiags...)
 		if resp.Diagnostics.HasError() {
@@ -500,7 +557,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tflog.Debug(ctx, "Updating user custom claims", map[string]any{
 			"id": plan.ID.ValueString(),
 		})
-		updatedClaims, err := r.client.UpdateUserCustomClaims(plan.ID.ValueString(), claims)
+		updatedClaims, err := r.client.UpdateUserCustomClaims(ctx, plan.ID.ValueString(), claims)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating user custom claims",
@@ -509,7 +566,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 
-		claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims)
+		claimsMap, claimDiags := customClaimsToState(ctx, updatedClaims, isManaged(plan.CustomClaims))
 		resp.Diagnostics.Append(claimDiags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -537,8 +594,9 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	})
 
 	// Delete the user
-	err := r.client.DeleteUser(state.ID.ValueString())
-	if err != nil {
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
+	// A user that no longer exists has already been deleted.
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting user",
 			"Could not delete user, unexpected error: "+err.Error(),
@@ -555,4 +613,9 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and set it as the resource ID
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// An empty set and map mark the groups and custom claims as managed so
+	// Read imports them.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), types.SetValueMust(types.StringType, nil))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("custom_claims"), types.MapValueMust(types.StringType, nil))...)
 }
@@ -0,0 +1,174 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Trozz/terraform-provider-pocketid/internal/resources"
+)
+
+// State written by earlier versions holds the memberships and custom claims of
+// users whose configuration never set groups or custom_claims. Removing the
+// attributes must keep them.
+func TestUserResource_Update_RemovingUnmanagedAttributes(t *testing.T) {
+	tests := []struct {
+		attribute string
+		value     tftypes.Value
+	}{
+		{attribute: "groups", value: stringSet("group-a")},
+		{attribute: "custom_claims", value: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"department": tftypes.NewValue(tftypes.String, "engineering"),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute, func(t *testing.T) {
+			ctx := context.Background()
+
+			testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "PUT" && r.URL.Path == "/api/users/user-123":
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"id": "user-123", "username": "jdoe", "email": "jdoe@example.com"}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			r := resources.NewUserResource()
+			plan := configuredResource(t, r, testClient)
+			attrs := map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, "user-123"),
+				"username":       tftypes.NewValue(tftypes.String, "jdoe"),
+				"email":          tftypes.NewValue(tftypes.String, "jdoe@example.com"),
+				"email_verified": tftypes.NewValue(tftypes.Bool, false),
+				"is_admin":       tftypes.NewValue(tftypes.Bool, false),
+				"disabled":       tftypes.NewValue(tftypes.Bool, false),
+			}
+			plan.Raw = objectValue(t, plan, attrs)
+			attrs[tt.attribute] = tt.value
+			state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, attrs)}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+			var value attr.Value
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(tt.attribute), &value)...)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.True(t, value.IsNull())
+		})
+	}
+}
+
+// An empty groups set removes every group and keeps the attribute managed, so
+// it must stay an empty set through apply and refresh.
+func TestUserResource_EmptyGroups(t *testing.T) {
+	ctx := context.Background()
+
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users",
+			r.Method == "GET" && r.URL.Path == "/api/users/user-123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "user-123", "username": "jdoe", "email": "jdoe@example.com", "userGroups": []}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewUserResource()
+	plan := configuredResource(t, r, testClient)
+	plan.Raw = objectValue(t, plan, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"username":       tftypes.NewValue(tftypes.String, "jdoe"),
+		"email":          tftypes.NewValue(tftypes.String, "jdoe@example.com"),
+		"email_verified": tftypes.NewValue(tftypes.Bool, false),
+		"is_admin":       tftypes.NewValue(tftypes.Bool, false),
+		"disabled":       tftypes.NewValue(tftypes.Bool, false),
+		"groups":         stringSet(),
+	})
+	emptyGroups := types.SetValueMust(types.StringType, []attr.Value{})
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), "unexpected diagnostics: %v", createResp.Diagnostics)
+
+	var groups types.Set
+	require.False(t, createResp.State.GetAttribute(ctx, path.Root("groups"), &groups).HasError())
+	assert.Equal(t, emptyGroups, groups)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "unexpected diagnostics: %v", readResp.Diagnostics)
+
+	require.False(t, readResp.State.GetAttribute(ctx, path.Root("groups"), &groups).HasError())
+	assert.Equal(t, emptyGroups, groups)
+}
+
+// An empty custom_claims map removes every claim and keeps the attribute
+// managed, so it must stay an empty map through apply and refresh.
+func TestUserResource_EmptyCustomClaims(t *testing.T) {
+	ctx := context.Background()
+
+	var sentClaims string
+	testClient := createMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/api/users/user-123",
+			r.Method == "GET" && r.URL.Path == "/api/users/user-123":
+			_, _ = w.Write([]byte(`{"id": "user-123", "username": "jdoe", "email": "jdoe@example.com", "customClaims": []}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/custom-claims/user/user-123":
+			body, _ := io.ReadAll(r.Body)
+			sentClaims = string(body)
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	r := resources.NewUserResource()
+	plan := configuredResource(t, r, testClient)
+	attrs := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "user-123"),
+		"username":       tftypes.NewValue(tftypes.String, "jdoe"),
+		"email":          tftypes.NewValue(tftypes.String, "jdoe@example.com"),
+		"email_verified": tftypes.NewValue(tftypes.Bool, false),
+		"is_admin":       tftypes.NewValue(tftypes.Bool, false),
+		"disabled":       tftypes.NewValue(tftypes.Bool, false),
+		"custom_claims":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+	}
+	plan.Raw = objectValue(t, plan, attrs)
+	attrs["custom_claims"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"department": tftypes.NewValue(tftypes.String, "engineering"),
+	})
+	state := tfsdk.State{Schema: plan.Schema, Raw: objectValue(t, plan, attrs)}
+	emptyClaims := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), "unexpected diagnostics: %v", updateResp.Diagnostics)
+	assert.JSONEq(t, `[]`, sentClaims)
+
+	var claims types.Map
+	require.False(t, updateResp.State.GetAttribute(ctx, path.Root("custom_claims"), &claims).HasError())
+	assert.Equal(t, emptyClaims, claims)
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "unexpected diagnostics: %v", readResp.Diagnostics)
+
+	require.False(t, readResp.State.GetAttribute(ctx, path.Root("custom_claims"), &claims).HasError())
+	assert.Equal(t, emptyClaims, claims)
+}